@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
 	optimizerConfigurator "github.com/lothar1998/v2x-optimizer/internal/performance/optimizer/configurator"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner/concurrent"
 	"github.com/spf13/cobra"
 )
@@ -14,8 +19,27 @@ const (
 	outputCSVFileFlag        = "output"
 	verboseConsoleOutputFlat = "verbose"
 	modelExecutorThreadLimit = "threads"
+	referenceFlag            = "reference"
+	referenceFileFlag        = "reference-file"
+	continueOnErrorFlag      = "continue-on-error"
+	timeoutPerRunFlag        = "timeout-per-run"
+	timeBudgetFlag           = "time-budget"
+	validateFlag             = "validate"
+	repeatFlag               = "repeat"
+	solverCommandFlag        = "solver-command"
+	solverModelFormatFlag    = "solver-model-format"
 )
 
+const (
+	lpModelFormat  = "lp"
+	mpsModelFormat = "mps"
+)
+
+var modelFormats = map[string]executor.ModelFormat{
+	lpModelFormat:  executor.LPModelFormat,
+	mpsModelFormat: executor.MPSModelFormat,
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "v2x-optimizer-performance",
 	Short: "V2X optimizer performance tool",
@@ -39,16 +63,19 @@ func Execute() {
 	setUpFlags(performanceOfCmd)
 	rootCmd.AddCommand(performanceOfCmd)
 
+	rootCmd.AddCommand(SweepCmd())
+
 	cobra.CheckErr(rootCmd.Execute())
 }
 
 func performanceOf(optimizerName string, configurators []optimizerConfigurator.Configurator) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   fmt.Sprintf("%s {model_file} {data_file | data_dir}... ", optimizerName),
-		Args:  cobra.MinimumNArgs(2),
+		Use:   fmt.Sprintf("%s [model_file] {data_file | data_dir}... ", optimizerName),
+		Args:  cobra.MinimumNArgs(1),
 		Short: fmt.Sprintf("Verify performance of %s optimizer", optimizerName),
-		Long:  fmt.Sprintf("Allows for performance verification of %s optimizer", optimizerName),
-		RunE:  computePerformanceUsing(configurators),
+		Long: fmt.Sprintf("Allows for performance verification of %s optimizer. "+
+			"The model_file is required only if %s is used as a reference", optimizerName, config.CPLEXOptimizerName),
+		RunE: computePerformanceUsing(configurators),
 	}
 
 	for _, configurator := range configurators {
@@ -60,36 +87,34 @@ func performanceOf(optimizerName string, configurators []optimizerConfigurator.C
 
 func computePerformanceUsing(configurators []optimizerConfigurator.Configurator) func(*cobra.Command, []string) error {
 	return func(command *cobra.Command, args []string) error {
-		modelFile := args[0]
-		dataFiles := args[1:]
-
-		// TODO add merging common paths into one to do not compute one thing several times
-
-		threadLimit, err := command.Flags().GetUint(modelExecutorThreadLimit)
+		reference, dataFiles, err := buildReference(command, configurators, args)
 		if err != nil {
 			return err
 		}
 
-		optimizers := make([]optimizer.PerformanceSubjectOptimizer, len(configurators))
-		for i, configurator := range configurators {
+		// TODO add merging common paths into one to do not compute one thing several times
+
+		optimizers := make([]optimizer.PerformanceSubjectOptimizer, 0, len(configurators))
+
+		for _, configurator := range configurators {
 			build := configurator.Builder()
 			opt, err := build(command)
 			if err != nil {
 				return err
 			}
-			optimizers[i] = opt
-		}
 
-		concurrentRunner := concurrent.NewRunnerWithLimits(dataFiles, optimizers, modelFile, threadLimit)
+			if opt.Identifier() == reference.Name {
+				continue
+			}
+
+			optimizers = append(optimizers, opt)
+		}
 
-		result, err := concurrentRunner.Run(command.Context())
+		r, err := computeReport(command, reference, dataFiles, optimizers)
 		if err != nil {
 			return err
 		}
 
-		errs := toErrors(result)
-		avgErrs := toAverageErrors(errs)
-
 		outputFile, err := command.Flags().GetString(outputCSVFileFlag)
 		if err != nil {
 			return err
@@ -101,16 +126,227 @@ func computePerformanceUsing(configurators []optimizerConfigurator.Configurator)
 				return err
 			}
 
-			outputToConsole(errs, avgErrs, isVerboseSet)
+			outputToConsole(r, isVerboseSet)
 			return nil
 		}
 
-		return outputToCSVFile(errs, avgErrs, outputFile)
+		return outputToCSVFile(r, outputFile)
 	}
 }
 
+// computeReport runs the reference and all optimizers for given data files using runner options
+// defined by command flags and computes statistics of the results.
+func computeReport(
+	command *cobra.Command,
+	reference executor.Reference,
+	dataFiles []string,
+	optimizers []optimizer.PerformanceSubjectOptimizer,
+) (*report, error) {
+	continueOnError, err := command.Flags().GetBool(continueOnErrorFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutPerRun, err := command.Flags().GetDuration(timeoutPerRunFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	timeBudget, err := command.Flags().GetDuration(timeBudgetFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	validate, err := command.Flags().GetBool(validateFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	repeat, err := command.Flags().GetUint(repeatFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	options := runner.Options{
+		ContinueOnError: continueOnError,
+		TimeoutPerRun:   timeoutPerRun,
+		ValidateResults: validate,
+		Repeat:          int(repeat),
+	}
+
+	concurrentRunner := concurrent.NewRunnerWithOptions(dataFiles, optimizers, reference, options)
+
+	ctx := command.Context()
+	if timeBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeBudget)
+		defer cancel()
+	}
+
+	result, err := concurrentRunner.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReport(result, reference.Name, reference.IsLowerBound), nil
+}
+
 func setUpFlags(c *cobra.Command) {
 	c.Flags().StringP(outputCSVFileFlag, "o", "", "path to output CSV file")
 	c.Flags().BoolP(verboseConsoleOutputFlat, "v", false, "verbose console output")
-	c.Flags().UintP(modelExecutorThreadLimit, "t", 0, "thread pool for CPLEX optimizer (0 - use default CPLEX config)")
+	c.Flags().UintP(modelExecutorThreadLimit, "t", 0,
+		"thread pool for CPLEX optimizer or external solver (0 - use default config of the solver)")
+	c.Flags().StringP(referenceFlag, "r", config.CPLEXOptimizerName,
+		"reference optimizer used to compute errors [ "+strings.Join(availableReferences(), " | ")+" ]")
+	c.Flags().StringP(referenceFileFlag, "", "",
+		"path to CSV file with lines in form of \"data_file,value\" (required by "+
+			config.KnownValuesReferenceName+" reference)")
+	c.Flags().BoolP(continueOnErrorFlag, "", false,
+		"record failures of optimizers as outcomes of particular files instead of aborting the whole run")
+	c.Flags().DurationP(timeoutPerRunFlag, "", 0,
+		"time limit of a single optimizer run, e.g. 30s or 5m (0 - no limit)")
+	c.Flags().DurationP(timeBudgetFlag, "", 0,
+		"time limit of the whole benchmark; runs that are not finished within it are recorded as timeouts (0 - no limit)")
+	c.Flags().BoolP(validateFlag, "", false, "verify that every computed result is a feasible solution")
+	c.Flags().UintP(repeatFlag, "", 1,
+		"number of runs of non-deterministic optimizers per file; with more than one run, min, mean, max"+
+			" and standard deviation of RRH count and relative error are reported")
+	c.Flags().StringP(solverCommandFlag, "", "",
+		"command template of external MIP solver with {model_file}, {solution_file} and {threads} placeholders,"+
+			" e.g. \"cbc {model_file} threads {threads} solve solu {solution_file}\"; if {solution_file} is omitted,"+
+			" the standard output is parsed (required by "+config.ExternalSolverReferenceName+" reference)")
+	c.Flags().StringP(solverModelFormatFlag, "", lpModelFormat,
+		"format of the model passed to external MIP solver [ "+lpModelFormat+" | "+mpsModelFormat+" ]")
+}
+
+func buildReference(
+	command *cobra.Command,
+	configurators []optimizerConfigurator.Configurator,
+	args []string,
+) (executor.Reference, []string, error) {
+	referenceName, err := command.Flags().GetString(referenceFlag)
+	if err != nil {
+		return executor.Reference{}, nil, err
+	}
+
+	switch referenceName {
+	case config.CPLEXOptimizerName:
+		if len(args) < 2 {
+			return executor.Reference{}, nil, errors.New("model file and at least one data path are required")
+		}
+
+		threadLimit, err := command.Flags().GetUint(modelExecutorThreadLimit)
+		if err != nil {
+			return executor.Reference{}, nil, err
+		}
+
+		return executor.NewCplexReference(args[0], threadLimit), args[1:], nil
+
+	case config.KnownValuesReferenceName:
+		referenceFile, err := command.Flags().GetString(referenceFileFlag)
+		if err != nil {
+			return executor.Reference{}, nil, err
+		}
+
+		if referenceFile == "" {
+			return executor.Reference{}, nil, errors.New("reference file is required")
+		}
+
+		values, err := executor.LoadKnownValues(referenceFile)
+		if err != nil {
+			return executor.Reference{}, nil, err
+		}
+
+		return executor.NewKnownValuesReference(values), args, nil
+
+	case config.LowerBoundReferenceName:
+		return executor.NewLowerBoundReference(), args, nil
+
+	case config.ExternalSolverReferenceName:
+		solver, err := buildExternalSolver(command)
+		if err != nil {
+			return executor.Reference{}, nil, err
+		}
+
+		return executor.NewExternalSolverReference(solver), args, nil
+	}
+
+	for _, configurator := range config.RegisteredOptimizerConfigurators {
+		if configurator.TypeName() != referenceName {
+			continue
+		}
+
+		opt, err := buildReferenceOptimizer(command, configurators, configurator)
+		if err != nil {
+			return executor.Reference{}, nil, err
+		}
+
+		return executor.NewCustomReference(opt), args, nil
+	}
+
+	return executor.Reference{}, nil, fmt.Errorf("unknown reference: %s", referenceName)
+}
+
+func buildExternalSolver(command *cobra.Command) (executor.ExternalSolver, error) {
+	commandTemplate, err := command.Flags().GetString(solverCommandFlag)
+	if err != nil {
+		return executor.ExternalSolver{}, err
+	}
+
+	if strings.TrimSpace(commandTemplate) == "" {
+		return executor.ExternalSolver{}, errors.New("solver command is required")
+	}
+
+	formatName, err := command.Flags().GetString(solverModelFormatFlag)
+	if err != nil {
+		return executor.ExternalSolver{}, err
+	}
+
+	modelFormat, ok := modelFormats[formatName]
+	if !ok {
+		return executor.ExternalSolver{}, fmt.Errorf("unknown model format: %s", formatName)
+	}
+
+	threadLimit, err := command.Flags().GetUint(modelExecutorThreadLimit)
+	if err != nil {
+		return executor.ExternalSolver{}, err
+	}
+
+	return executor.ExternalSolver{
+		Name:            config.ExternalSolverReferenceName,
+		CommandTemplate: commandTemplate,
+		ModelFormat:     modelFormat,
+		ThreadLimit:     threadLimit,
+	}, nil
+}
+
+// buildReferenceOptimizer builds the reference optimizer using parameters given by command flags
+// if the optimizer is also verified by the command. Otherwise, it uses its default parameters.
+func buildReferenceOptimizer(
+	command *cobra.Command,
+	configurators []optimizerConfigurator.Configurator,
+	referenceConfigurator optimizerConfigurator.Configurator,
+) (optimizer.PerformanceSubjectOptimizer, error) {
+	for _, configurator := range configurators {
+		if configurator.TypeName() == referenceConfigurator.TypeName() {
+			return configurator.Builder()(command)
+		}
+	}
+
+	defaultCommand := &cobra.Command{}
+	referenceConfigurator.SetUpFlags(defaultCommand)
+	return referenceConfigurator.Builder()(defaultCommand)
+}
+
+func availableReferences() []string {
+	references := []string{
+		config.CPLEXOptimizerName,
+		config.KnownValuesReferenceName,
+		config.LowerBoundReferenceName,
+		config.ExternalSolverReferenceName,
+	}
+	for _, configurator := range config.RegisteredOptimizerConfigurators {
+		references = append(references, configurator.TypeName())
+	}
+	return references
 }
@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	optimizerConfigurator "github.com/lothar1998/v2x-optimizer/internal/performance/optimizer/configurator"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	sweepValueSeparator = ","
+	sweepRangeSeparator = ".."
+)
+
+// rankedConfiguration represents statistics of a single parameterised optimizer within a sweep.
+type rankedConfiguration struct {
+	Optimizer string
+	AvgErrors *AvgErrors
+	FailureRate
+	AverageRuntime string
+}
+
+// SweepCmd returns cobra.Command which is able to evaluate optimizers for all combinations of their parameters.
+// It should be registered in root command using AddCommand() method.
+func SweepCmd() *cobra.Command {
+	sweepCmd := &cobra.Command{
+		Use:   "sweep",
+		Short: "Verify performance of optimizers for all combinations of parameters",
+		Long: "Allows for verification of optimizers for all combinations of given parameter values. " +
+			"Each parameter accepts a list of values and integer ranges, e.g. 0..6 or 1,2,4,8. " +
+			"All configurations share the results of the reference and are ranked by their failure rate, " +
+			"average relative error and average runtime",
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	for _, configurator := range config.RegisteredOptimizerConfigurators {
+		sweepOfCmd := sweepOf(configurator.TypeName(), []optimizerConfigurator.Configurator{configurator})
+		setUpFlags(sweepOfCmd)
+		sweepCmd.AddCommand(sweepOfCmd)
+	}
+
+	sweepOfCmd := sweepOf("all", config.RegisteredOptimizerConfigurators)
+	setUpFlags(sweepOfCmd)
+	sweepCmd.AddCommand(sweepOfCmd)
+
+	return sweepCmd
+}
+
+func sweepOf(optimizerName string, configurators []optimizerConfigurator.Configurator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [model_file] {data_file | data_dir}... ", optimizerName),
+		Args:  cobra.MinimumNArgs(1),
+		Short: fmt.Sprintf("Verify performance of %s optimizer for all combinations of parameters", optimizerName),
+		Long: fmt.Sprintf("Allows for performance verification of %s optimizer for all combinations "+
+			"of given parameter values. The model_file is required only if %s is used as a reference",
+			optimizerName, config.CPLEXOptimizerName),
+		RunE: sweepUsing(configurators),
+	}
+
+	for _, configurator := range configurators {
+		setUpSweepFlags(cmd, configurator)
+	}
+
+	return cmd
+}
+
+func sweepUsing(configurators []optimizerConfigurator.Configurator) func(*cobra.Command, []string) error {
+	return func(command *cobra.Command, args []string) error {
+		// flags of the sweep define lists of values, so the reference uses its default parameters
+		reference, dataFiles, err := buildReference(command, nil, args)
+		if err != nil {
+			return err
+		}
+
+		var optimizers []optimizer.PerformanceSubjectOptimizer
+		identifiers := make(map[string]bool)
+
+		for _, configurator := range configurators {
+			sweptOptimizers, err := buildSweptOptimizers(command, configurator)
+			if err != nil {
+				return err
+			}
+
+			for _, opt := range sweptOptimizers {
+				if opt.Identifier() == reference.Name || identifiers[opt.Identifier()] {
+					continue
+				}
+
+				identifiers[opt.Identifier()] = true
+				optimizers = append(optimizers, opt)
+			}
+		}
+
+		r, err := computeReport(command, reference, dataFiles, optimizers)
+		if err != nil {
+			return err
+		}
+
+		outputFile, err := command.Flags().GetString(outputCSVFileFlag)
+		if err != nil {
+			return err
+		}
+
+		if outputFile == "" {
+			isVerboseSet, err := command.Flags().GetBool(verboseConsoleOutputFlat)
+			if err != nil {
+				return err
+			}
+
+			outputRankingToConsole(r)
+
+			if isVerboseSet {
+				outputToConsole(r, true)
+			}
+
+			return nil
+		}
+
+		return outputRankingToCSVFile(r, outputFile)
+	}
+}
+
+// setUpSweepFlags registers flags of the configurator as string flags that accept lists of values.
+// Default values of the flags remain the same.
+func setUpSweepFlags(command *cobra.Command, configurator optimizerConfigurator.Configurator) {
+	template := &cobra.Command{}
+	configurator.SetUpFlags(template)
+
+	template.Flags().VisitAll(func(flag *pflag.Flag) {
+		command.Flags().StringP(flag.Name, "", flag.DefValue,
+			flag.Usage+"\n(accepts a list of values and integer ranges, e.g. 0..6 or 1,2,4,8)")
+	})
+}
+
+// buildSweptOptimizers builds the optimizer for each combination of parameter values given by command flags.
+func buildSweptOptimizers(
+	command *cobra.Command,
+	configurator optimizerConfigurator.Configurator,
+) ([]optimizer.PerformanceSubjectOptimizer, error) {
+	template := &cobra.Command{}
+	configurator.SetUpFlags(template)
+
+	var names []string
+	var values [][]string
+	var err error
+
+	template.Flags().VisitAll(func(flag *pflag.Flag) {
+		if err != nil {
+			return
+		}
+
+		var spec string
+		spec, err = command.Flags().GetString(flag.Name)
+		if err != nil {
+			return
+		}
+
+		var expanded []string
+		expanded, err = expandValues(spec)
+		if err != nil {
+			err = fmt.Errorf("invalid values of %s: %w", flag.Name, err)
+			return
+		}
+
+		names = append(names, flag.Name)
+		values = append(values, expanded)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var optimizers []optimizer.PerformanceSubjectOptimizer
+
+	for _, combination := range cartesianProduct(values) {
+		configuratorCommand := &cobra.Command{}
+		configurator.SetUpFlags(configuratorCommand)
+
+		for i, name := range names {
+			if err := configuratorCommand.Flags().Set(name, combination[i]); err != nil {
+				return nil, fmt.Errorf("invalid value of %s: %w", name, err)
+			}
+		}
+
+		opt, err := configurator.Builder()(configuratorCommand)
+		if err != nil {
+			return nil, err
+		}
+
+		optimizers = append(optimizers, opt)
+	}
+
+	return optimizers, nil
+}
+
+// expandValues converts the specification of parameter values into the list of values.
+// The specification is a comma-separated list of values and inclusive integer ranges, e.g. "0..3,8".
+func expandValues(spec string) ([]string, error) {
+	var values []string
+
+	for _, item := range strings.Split(spec, sweepValueSeparator) {
+		item = strings.TrimSpace(item)
+
+		bounds := strings.Split(item, sweepRangeSeparator)
+		if len(bounds) != 2 {
+			values = append(values, item)
+			continue
+		}
+
+		from, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %s: %w", item, err)
+		}
+
+		to, err := strconv.Atoi(bounds[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %s: %w", item, err)
+		}
+
+		if from > to {
+			return nil, fmt.Errorf("invalid range %s: lower bound is greater than upper bound", item)
+		}
+
+		for v := from; v <= to; v++ {
+			values = append(values, strconv.Itoa(v))
+		}
+	}
+
+	return values, nil
+}
+
+// cartesianProduct returns all combinations that consist of one value from each list.
+func cartesianProduct(lists [][]string) [][]string {
+	combinations := [][]string{{}}
+
+	for _, list := range lists {
+		var next [][]string
+
+		for _, combination := range combinations {
+			for _, value := range list {
+				extended := make([]string, len(combination), len(combination)+1)
+				copy(extended, combination)
+				next = append(next, append(extended, value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
+// rankConfigurations orders optimizers of the path by their failure rate, average relative error
+// and average runtime. Optimizers without any successful run are placed at the end. The reference is omitted.
+func rankConfigurations(r *report, path string) []rankedConfiguration {
+	var ranking []rankedConfiguration
+
+	for opt, failureRate := range r.failureRates[path] {
+		if opt == r.referenceName {
+			continue
+		}
+
+		c := rankedConfiguration{Optimizer: opt, FailureRate: failureRate}
+
+		if avgErrs, ok := r.avgErrs[path][opt]; ok {
+			c.AvgErrors = &avgErrs
+		}
+
+		ranking = append(ranking, c)
+	}
+
+	sort.Slice(ranking, func(i, j int) bool {
+		ci, cj := ranking[i], ranking[j]
+
+		if (ci.AvgErrors == nil) != (cj.AvgErrors == nil) {
+			return ci.AvgErrors != nil
+		}
+
+		if ci.Rate() != cj.Rate() {
+			return ci.Rate() < cj.Rate()
+		}
+
+		if ci.AvgErrors != nil && ci.AvgErrors.AvgRelativeError != cj.AvgErrors.AvgRelativeError {
+			return ci.AvgErrors.AvgRelativeError < cj.AvgErrors.AvgRelativeError
+		}
+
+		if ri, rj := r.runtimes[path][ci.Optimizer].Average, r.runtimes[path][cj.Optimizer].Average; ri != rj {
+			return ri < rj
+		}
+
+		return ci.Optimizer < cj.Optimizer
+	})
+
+	for i := range ranking {
+		if runtime, ok := r.runtimes[path][ranking[i].Optimizer]; ok {
+			ranking[i].AverageRuntime = formatMilliseconds(runtime.Average)
+		}
+	}
+
+	return ranking
+}
+
+func outputRankingToConsole(r *report) {
+	w := tabwriter.NewWriter(os.Stdout, 1, 1, 5, ' ', 0)
+
+	for path := range r.failureRates {
+		_, _ = fmt.Fprintf(w, "Path: "+path)
+		_, _ = fmt.Fprint(w, "\n\n")
+
+		kind := errorKind(r.referenceIsLowerBound)
+		_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			"Rank", "Optimizer", "Failure rate", "Average relative "+kind, "Average absolute "+kind,
+			"Average runtime [ms]")
+
+		for i, c := range rankConfigurations(r, path) {
+			relativeError, absoluteError, runtime := "-", "-", "-"
+			if c.AvgErrors != nil {
+				relativeError = strconv.FormatFloat(c.AvgErrors.AvgRelativeError, 'f', 3, 64)
+				absoluteError = strconv.FormatFloat(c.AvgErrors.AvgAbsolutError, 'f', 3, 64)
+			}
+
+			if c.AverageRuntime != "" {
+				runtime = c.AverageRuntime
+			}
+
+			_, _ = fmt.Fprintf(w, "\t%d\t%s\t%.3f (%d/%d)\t%s\t%s\t%s\n",
+				i+1, c.Optimizer, c.Rate(), c.Failures(), c.Runs, relativeError, absoluteError, runtime)
+		}
+
+		_, _ = fmt.Fprint(w, "\n")
+		_, _ = fmt.Fprintln(w, strings.Repeat("-", 100))
+	}
+
+	_ = w.Flush()
+}
+
+func outputRankingToCSVFile(r *report, outputFilepath string) error {
+	err := os.MkdirAll(outputFilepath, 0755)
+	if err != nil {
+		return err
+	}
+
+	for path := range r.failureRates {
+		rootFilepath := filepath.Join(outputFilepath, pathToUnderscoreValue(path))
+
+		csvFile, err := os.OpenFile(rootFilepath+"_sweep.csv", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+
+		err = writeRanking(rankConfigurations(r, path), r.referenceIsLowerBound, csvFile)
+		_ = csvFile.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeRanking(ranking []rankedConfiguration, referenceIsLowerBound bool, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	kind := errorKind(referenceIsLowerBound)
+	header := []string{
+		"rank",
+		"optimizer",
+		"failure rate",
+		"average relative " + kind,
+		"average absolute " + kind,
+		"average runtime [ms]",
+	}
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for i, c := range ranking {
+		relativeError, absoluteError := "", ""
+		if c.AvgErrors != nil {
+			relativeError = strconv.FormatFloat(c.AvgErrors.AvgRelativeError, 'f', 3, 64)
+			absoluteError = strconv.FormatFloat(c.AvgErrors.AvgAbsolutError, 'f', 3, 64)
+		}
+
+		err := writer.Write([]string{
+			strconv.Itoa(i + 1),
+			c.Optimizer,
+			strconv.FormatFloat(c.Rate(), 'f', 3, 64),
+			relativeError,
+			absoluteError,
+			c.AverageRuntime,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
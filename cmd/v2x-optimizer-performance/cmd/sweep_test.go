@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	optimizerConfigurator "github.com/lothar1998/v2x-optimizer/internal/performance/optimizer/configurator"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_expandValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected []string
+		isError  bool
+	}{
+		{"should return single value", "3", []string{"3"}, false},
+		{"should return list of values", "1,2,4,8", []string{"1", "2", "4", "8"}, false},
+		{"should expand range", "0..3", []string{"0", "1", "2", "3"}, false},
+		{"should expand mixed ranges and values", "0..1, 5", []string{"0", "1", "5"}, false},
+		{"should accept non-numeric values", "true,false", []string{"true", "false"}, false},
+		{"should reject range with non-numeric bound", "a..3", nil, true},
+		{"should reject range with reversed bounds", "3..1", nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := expandValues(tt.spec)
+
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func Test_cartesianProduct(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return all combinations", func(t *testing.T) {
+		t.Parallel()
+
+		combinations := cartesianProduct([][]string{{"a", "b"}, {"1", "2", "3"}})
+
+		assert.Equal(t, [][]string{
+			{"a", "1"}, {"a", "2"}, {"a", "3"},
+			{"b", "1"}, {"b", "2"}, {"b", "3"},
+		}, combinations)
+	})
+
+	t.Run("should return single empty combination for no lists", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, [][]string{{}}, cartesianProduct(nil))
+	})
+}
+
+func Test_buildSweptOptimizers(t *testing.T) {
+	t.Parallel()
+
+	configurator := optimizerConfigurator.BucketPoolBestFitConfigurator{}
+
+	t.Run("should build optimizer for each combination of parameters", func(t *testing.T) {
+		t.Parallel()
+
+		command := &cobra.Command{}
+		setUpSweepFlags(command, configurator)
+		assert.NoError(t, command.Flags().Set("bpbf_fit", "0..2"))
+		assert.NoError(t, command.Flags().Set("bpbf_init_pool_size", "1,4"))
+
+		optimizers, err := buildSweptOptimizers(command, configurator)
+
+		assert.NoError(t, err)
+		assert.Len(t, optimizers, 6)
+
+		identifiers := make([]string, len(optimizers))
+		for i, opt := range optimizers {
+			identifiers[i] = opt.Identifier()
+		}
+
+		assert.Contains(t, identifiers, "BucketPoolBestFit,BucketReorderFuncID:0,FitnessFuncID:2,InitPoolSize:4")
+		assert.Contains(t, identifiers, "BucketPoolBestFit,BucketReorderFuncID:0,FitnessFuncID:0,InitPoolSize:1")
+	})
+
+	t.Run("should use default values of parameters that are not swept", func(t *testing.T) {
+		t.Parallel()
+
+		command := &cobra.Command{}
+		setUpSweepFlags(command, configurator)
+
+		optimizers, err := buildSweptOptimizers(command, configurator)
+
+		assert.NoError(t, err)
+		assert.Len(t, optimizers, 1)
+		assert.Equal(t, "BucketPoolBestFit,BucketReorderFuncID:0,FitnessFuncID:0,InitPoolSize:1",
+			optimizers[0].Identifier())
+	})
+
+	t.Run("should return error for unsupported parameter value", func(t *testing.T) {
+		t.Parallel()
+
+		command := &cobra.Command{}
+		setUpSweepFlags(command, configurator)
+		assert.NoError(t, command.Flags().Set("bpbf_fit", "6..7"))
+
+		_, err := buildSweptOptimizers(command, configurator)
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_rankConfigurations(t *testing.T) {
+	t.Parallel()
+
+	r := &report{
+		referenceName: "reference",
+		avgErrs: PathsToAvgErrors{"/path/1": OptimizersToAvgErrors{
+			"opt1": AvgErrors{AvgRelativeError: 0.5},
+			"opt2": AvgErrors{AvgRelativeError: 0.2},
+			"opt3": AvgErrors{AvgRelativeError: 0.2},
+			"opt4": AvgErrors{AvgRelativeError: 0.1},
+		}},
+		runtimes: PathsToRuntimes{"/path/1": OptimizersToRuntimes{
+			"opt2": timing.Summary{Average: 2 * time.Millisecond},
+			"opt3": timing.Summary{Average: time.Millisecond},
+		}},
+		failureRates: PathsToFailureRates{"/path/1": OptimizersToFailureRates{
+			"reference": FailureRate{Runs: 2},
+			"opt1":      FailureRate{Runs: 2},
+			"opt2":      FailureRate{Runs: 2},
+			"opt3":      FailureRate{Runs: 2},
+			"opt4":      FailureRate{Runs: 2, Infeasible: 1},
+			"opt5":      FailureRate{Runs: 2, Errors: 2},
+		}},
+	}
+
+	ranking := rankConfigurations(r, "/path/1")
+
+	order := make([]string, len(ranking))
+	for i, c := range ranking {
+		order[i] = c.Optimizer
+	}
+
+	assert.Equal(t, []string{"opt3", "opt2", "opt1", "opt4", "opt5"}, order)
+	assert.Equal(t, "1.000", ranking[0].AverageRuntime)
+	assert.Nil(t, ranking[4].AvgErrors)
+}
+
+func Test_writeRanking(t *testing.T) {
+	t.Parallel()
+
+	ranking := []rankedConfiguration{
+		{
+			Optimizer:      "opt1",
+			AvgErrors:      &AvgErrors{AvgRelativeError: 0.25, AvgAbsolutError: 1},
+			FailureRate:    FailureRate{Runs: 4},
+			AverageRuntime: "1.500",
+		},
+		{Optimizer: "opt2", FailureRate: FailureRate{Runs: 4, Timeouts: 4}},
+	}
+
+	var buffer bytes.Buffer
+
+	err := writeRanking(ranking, false, &buffer)
+	assert.NoError(t, err)
+
+	lines := strings.Split(buffer.String(), "\n")
+
+	assert.Equal(t, []string{
+		"rank,optimizer,failure rate,average relative error,average absolute error,average runtime [ms]",
+		"1,opt1,0.000,0.250,1.000,1.500",
+		"2,opt2,1.000,,,",
+		"",
+	}, lines)
+}
@@ -9,10 +9,12 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
-	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/distribution"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/errors"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 )
 
 type PathsToErrors map[string]FilesToErrors
@@ -30,20 +32,111 @@ type AvgErrors struct {
 	AvgAbsolutError  float64
 }
 
-func toErrors(results runner.PathsToResults) PathsToErrors {
+type PathsToTimings map[string]FilesToTimings
+
+type FilesToTimings map[string]OptimizersToTimings
+
+type OptimizersToTimings map[string]timing.Timing
+
+type PathsToRuntimes map[string]OptimizersToRuntimes
+
+type OptimizersToRuntimes map[string]timing.Summary
+
+type PathsToOutcomes map[string]FilesToOutcomes
+
+type FilesToOutcomes map[string]OptimizersToOutcomes
+
+type OptimizersToOutcomes map[string]runner.Outcome
+
+type PathsToFailureRates map[string]OptimizersToFailureRates
+
+type OptimizersToFailureRates map[string]FailureRate
+
+// FailureRate represents the number of runs of the optimizer and the number of its failures of each kind.
+type FailureRate struct {
+	Runs       int
+	Infeasible int
+	Errors     int
+	Timeouts   int
+}
+
+func (f FailureRate) Failures() int {
+	return f.Infeasible + f.Errors + f.Timeouts
+}
+
+func (f FailureRate) Rate() float64 {
+	if f.Runs == 0 {
+		return 0
+	}
+	return float64(f.Failures()) / float64(f.Runs)
+}
+
+type PathsToDistributions map[string]FilesToDistributions
+
+type FilesToDistributions map[string]OptimizersToDistributions
+
+type OptimizersToDistributions map[string]SampleDistribution
+
+// SampleDistribution describes values obtained by repeated runs of the optimizer for a single file.
+// RelativeError is nil if there is no reference value for the file.
+type SampleDistribution struct {
+	Runs          int
+	RRHCount      distribution.Distribution
+	RelativeError *distribution.Distribution
+}
+
+// report aggregates all statistics presented to the user. If the reference provides lower bounds,
+// errors are gaps to the lower bounds.
+type report struct {
+	referenceName         string
+	referenceIsLowerBound bool
+	errs                  PathsToErrors
+	avgErrs               PathsToAvgErrors
+	timings               PathsToTimings
+	runtimes              PathsToRuntimes
+	outcomes              PathsToOutcomes
+	failureRates          PathsToFailureRates
+	distributions         PathsToDistributions
+}
+
+func newReport(results runner.PathsToResults, referenceName string, referenceIsLowerBound bool) *report {
+	errs := toErrors(results, referenceName, referenceIsLowerBound)
+	timings := toTimings(results)
+	outcomes := toOutcomes(results)
+
+	return &report{
+		referenceName:         referenceName,
+		referenceIsLowerBound: referenceIsLowerBound,
+		errs:                  errs,
+		avgErrs:               toAverageErrors(errs),
+		timings:               timings,
+		runtimes:              toRuntimes(timings),
+		outcomes:              outcomes,
+		failureRates:          toFailureRates(outcomes),
+		distributions:         toDistributions(results, referenceName, referenceIsLowerBound),
+	}
+}
+
+// toErrors computes errors of successful runs. Files for which the reference failed are skipped,
+// since there is no value to compare with.
+func toErrors(results runner.PathsToResults, referenceName string, referenceIsLowerBound bool) PathsToErrors {
 	pathsToErrors := make(PathsToErrors)
 
 	for path, filesToResults := range results {
 		pathsToErrors[path] = make(FilesToErrors)
 
 		for file, optimizersToResults := range filesToResults {
-			pathsToErrors[path][file] = make(OptimizersToErrors)
+			referenceValue, ok := optimizersToResults[referenceName]
+			if !ok || referenceValue.Outcome != runner.OutcomeSuccess {
+				continue
+			}
 
-			cplexValue := optimizersToResults[config.CPLEXOptimizerName]
+			pathsToErrors[path][file] = make(OptimizersToErrors)
 
-			for opt, value := range optimizersToResults {
-				if opt != config.CPLEXOptimizerName {
-					pathsToErrors[path][file][opt] = *errors.Calculate(cplexValue, value)
+			for opt, result := range optimizersToResults {
+				if opt != referenceName && result.Outcome == runner.OutcomeSuccess {
+					reference := errors.Reference{Value: referenceValue.Value, IsLowerBound: referenceIsLowerBound}
+					pathsToErrors[path][file][opt] = *errors.Calculate(reference, result.Value)
 				}
 			}
 		}
@@ -89,47 +182,247 @@ func toAverageErrors(pathsToErrors PathsToErrors) PathsToAvgErrors {
 	return pathsToAvgErrors
 }
 
-func outputToConsole(errs PathsToErrors, avgErrs PathsToAvgErrors, isVerbose bool) {
+func toTimings(results runner.PathsToResults) PathsToTimings {
+	pathsToTimings := make(PathsToTimings)
+
+	for path, filesToResults := range results {
+		pathsToTimings[path] = make(FilesToTimings)
+
+		for file, optimizersToResults := range filesToResults {
+			pathsToTimings[path][file] = make(OptimizersToTimings)
+
+			for opt, result := range optimizersToResults {
+				if result.Outcome == runner.OutcomeSuccess {
+					pathsToTimings[path][file][opt] = result.Timing
+				}
+			}
+		}
+	}
+
+	return pathsToTimings
+}
+
+func toRuntimes(pathsToTimings PathsToTimings) PathsToRuntimes {
+	pathsToRuntimes := make(PathsToRuntimes)
+
+	for path, filesToTimings := range pathsToTimings {
+		optimizersToWallTimes := make(map[string][]time.Duration)
+
+		for _, optimizersToTimings := range filesToTimings {
+			for opt, t := range optimizersToTimings {
+				optimizersToWallTimes[opt] = append(optimizersToWallTimes[opt], t.WallTime)
+			}
+		}
+
+		pathsToRuntimes[path] = make(OptimizersToRuntimes)
+
+		for opt, wallTimes := range optimizersToWallTimes {
+			pathsToRuntimes[path][opt] = timing.Summarize(wallTimes)
+		}
+	}
+
+	return pathsToRuntimes
+}
+
+func toOutcomes(results runner.PathsToResults) PathsToOutcomes {
+	pathsToOutcomes := make(PathsToOutcomes)
+
+	for path, filesToResults := range results {
+		pathsToOutcomes[path] = make(FilesToOutcomes)
+
+		for file, optimizersToResults := range filesToResults {
+			pathsToOutcomes[path][file] = make(OptimizersToOutcomes)
+
+			for opt, result := range optimizersToResults {
+				pathsToOutcomes[path][file][opt] = result.Outcome
+			}
+		}
+	}
+
+	return pathsToOutcomes
+}
+
+func toFailureRates(pathsToOutcomes PathsToOutcomes) PathsToFailureRates {
+	pathsToFailureRates := make(PathsToFailureRates)
+
+	for path, filesToOutcomes := range pathsToOutcomes {
+		pathsToFailureRates[path] = make(OptimizersToFailureRates)
+
+		for _, optimizersToOutcomes := range filesToOutcomes {
+			for opt, outcome := range optimizersToOutcomes {
+				failureRate := pathsToFailureRates[path][opt]
+				failureRate.Runs++
+
+				switch outcome {
+				case runner.OutcomeInfeasible:
+					failureRate.Infeasible++
+				case runner.OutcomeError:
+					failureRate.Errors++
+				case runner.OutcomeTimeout:
+					failureRate.Timeouts++
+				}
+
+				pathsToFailureRates[path][opt] = failureRate
+			}
+		}
+	}
+
+	return pathsToFailureRates
+}
+
+// toDistributions describes values of successful repeated runs. Relative errors are computed
+// for each run separately, if the reference succeeded for the file.
+func toDistributions(
+	results runner.PathsToResults,
+	referenceName string,
+	referenceIsLowerBound bool,
+) PathsToDistributions {
+	pathsToDistributions := make(PathsToDistributions)
+
+	for path, filesToResults := range results {
+		pathsToDistributions[path] = make(FilesToDistributions)
+
+		for file, optimizersToResults := range filesToResults {
+			referenceValue, hasReference := optimizersToResults[referenceName]
+			hasReference = hasReference && referenceValue.Outcome == runner.OutcomeSuccess
+
+			for opt, result := range optimizersToResults {
+				if opt == referenceName || len(result.Samples) == 0 || result.Outcome != runner.OutcomeSuccess {
+					continue
+				}
+
+				d := SampleDistribution{Runs: len(result.Samples), RRHCount: distribution.DescribeInts(result.Samples)}
+
+				if hasReference {
+					reference := errors.Reference{Value: referenceValue.Value, IsLowerBound: referenceIsLowerBound}
+
+					relativeErrors := make([]float64, len(result.Samples))
+					for i, sample := range result.Samples {
+						relativeErrors[i] = errors.Calculate(reference, sample).RelativeError
+					}
+
+					relativeError := distribution.Describe(relativeErrors)
+					d.RelativeError = &relativeError
+				}
+
+				if _, ok := pathsToDistributions[path][file]; !ok {
+					pathsToDistributions[path][file] = make(OptimizersToDistributions)
+				}
+
+				pathsToDistributions[path][file][opt] = d
+			}
+		}
+	}
+
+	return pathsToDistributions
+}
+
+func outputToConsole(r *report, isVerbose bool) {
 	w := tabwriter.NewWriter(os.Stdout, 1, 1, 5, ' ', 0)
 
-	for path := range avgErrs {
+	for path := range r.failureRates {
 		_, _ = fmt.Fprintf(w, "Path: "+path)
 		_, _ = fmt.Fprint(w, "\n\n")
 
-		_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s\n",
-			"Optimizer", "Average relative error", "Average absolute error")
+		kind := errorKind(r.referenceIsLowerBound)
+		_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			"Optimizer", "Average relative "+kind, "Average absolute "+kind,
+			"Average runtime [ms]", "Median runtime [ms]", "P95 runtime [ms]", "Failure rate")
+
+		for opt, failureRate := range r.failureRates[path] {
+			relativeError, absoluteError := "-", "-"
+			if avgValues, ok := r.avgErrs[path][opt]; ok {
+				relativeError = strconv.FormatFloat(avgValues.AvgRelativeError, 'f', 3, 64)
+				absoluteError = strconv.FormatFloat(avgValues.AvgAbsolutError, 'f', 3, 64)
+			}
+
+			average, median, p95 := "-", "-", "-"
+			if runtime, ok := r.runtimes[path][opt]; ok {
+				average = formatMilliseconds(runtime.Average)
+				median = formatMilliseconds(runtime.Median)
+				p95 = formatMilliseconds(runtime.P95)
+			}
+
+			_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s\t%s\t%.3f (%d/%d)\n",
+				opt, relativeError, absoluteError, average, median, p95,
+				failureRate.Rate(), failureRate.Failures(), failureRate.Runs)
+		}
 
-		for opt, avgValues := range avgErrs[path] {
-			_, _ = fmt.Fprintf(w, "\t%s\t%.3f\t%.3f\n",
-				opt, avgValues.AvgRelativeError, avgValues.AvgAbsolutError)
+		if len(r.distributions[path]) > 0 {
+			_, _ = fmt.Fprint(w, "\n\n")
+			outputDistributionsToConsole(w, r.distributions[path], r.referenceIsLowerBound)
 		}
 
 		if isVerbose {
 			_, _ = fmt.Fprint(w, "\n\n")
+			outputDetailsToConsole(w, r, path)
+		}
 
-			for file, optimizersToErrors := range errs[path] {
-				_, _ = fmt.Fprintln(w, "\tFile: "+file)
-				_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t%s\t%s\t%s\n",
-					"Optimizer", "Value", "Optimal Value", "Relative Error", "Absolute Error")
+		_, _ = fmt.Fprint(w, "\n")
+		_, _ = fmt.Fprintln(w, strings.Repeat("-", 100))
+	}
 
-				for opt, v := range optimizersToErrors {
-					_, _ = fmt.Fprintf(w, "\t\t%s\t%d\t%d\t%.3f\t%d\n",
-						opt, v.Value, v.ReferenceValue, v.RelativeError, v.AbsoluteError)
-				}
+	_ = w.Flush()
+}
+
+func outputDetailsToConsole(w io.Writer, r *report, path string) {
+	for file, optimizersToOutcomes := range r.outcomes[path] {
+		_, _ = fmt.Fprintln(w, "\tFile: "+file)
+		referenceValue, kind := "Optimal Value", "Error"
+		if r.referenceIsLowerBound {
+			referenceValue, kind = "Lower Bound", "Gap"
+		}
+
+		_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			"Optimizer", "Outcome", "Value", referenceValue, "Relative "+kind, "Absolute "+kind,
+			"Wall time [ms]", "CPU time [ms]")
+
+		for opt, outcome := range optimizersToOutcomes {
+			if opt == r.referenceName && outcome == runner.OutcomeSuccess {
+				continue
+			}
 
-				_, _ = fmt.Fprint(w, "\n")
+			v, ok := r.errs[path][file][opt]
+			if !ok {
+				_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t-\t-\t-\t-\t-\t-\n", opt, outcome)
+				continue
 			}
+
+			t := r.timings[path][file][opt]
+			_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t%d\t%d\t%.3f\t%d\t%s\t%s\n",
+				opt, outcome, v.Value, v.ReferenceValue, v.RelativeError, v.AbsoluteError,
+				formatMilliseconds(t.WallTime), formatCPUTime(t.CPUTime))
 		}
 
 		_, _ = fmt.Fprint(w, "\n")
-		_, _ = fmt.Fprintln(w, strings.Repeat("-", 100))
 	}
+}
 
-	_ = w.Flush()
+func outputDistributionsToConsole(w io.Writer, filesToDistributions FilesToDistributions, referenceIsLowerBound bool) {
+	_, _ = fmt.Fprintln(w, "\tRepeated runs:")
+	_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t%s\t%s\t%s\n",
+		"File", "Optimizer", "Runs", "RRH count (min/mean/max/std)",
+		"Relative "+errorKind(referenceIsLowerBound)+" (min/mean/max/std)")
+
+	for file, optimizersToDistributions := range filesToDistributions {
+		for opt, d := range optimizersToDistributions {
+			relativeError := "-"
+			if d.RelativeError != nil {
+				relativeError = formatDistribution(*d.RelativeError)
+			}
+
+			_, _ = fmt.Fprintf(w, "\t\t%s\t%s\t%d\t%s\t%s\n",
+				file, opt, d.Runs, formatDistribution(d.RRHCount), relativeError)
+		}
+	}
+}
+
+func formatDistribution(d distribution.Distribution) string {
+	return fmt.Sprintf("%.3f/%.3f/%.3f/%.3f", d.Min, d.Mean, d.Max, d.StdDev)
 }
 
-func outputToCSVFile(errs PathsToErrors, avgErrs PathsToAvgErrors, outputFilepath string) error {
-	for path, optimizersToAvgErrors := range avgErrs {
+func outputToCSVFile(r *report, outputFilepath string) error {
+	for path, optimizersToFailureRates := range r.failureRates {
 		err := os.MkdirAll(outputFilepath, 0755)
 		if err != nil {
 			return err
@@ -142,7 +435,7 @@ func outputToCSVFile(errs PathsToErrors, avgErrs PathsToAvgErrors, outputFilepat
 			return err
 		}
 
-		err = writeAvgErrors(optimizersToAvgErrors, csvFile)
+		err = writeAvgErrors(r.avgErrs[path], r.runtimes[path], optimizersToFailureRates, r.referenceIsLowerBound, csvFile)
 		_ = csvFile.Close()
 
 		if err != nil {
@@ -154,12 +447,30 @@ func outputToCSVFile(errs PathsToErrors, avgErrs PathsToAvgErrors, outputFilepat
 			return err
 		}
 
-		err = writeErrors(errs[path], csvFileDetails)
+		err = writeErrors(r.errs[path], r.timings[path], r.outcomes[path], r.referenceName, r.referenceIsLowerBound,
+			csvFileDetails)
 		_ = csvFileDetails.Close()
 
 		if err != nil {
 			return err
 		}
+
+		if len(r.distributions[path]) == 0 {
+			continue
+		}
+
+		csvFileDistributions, err := os.OpenFile(rootFilepath+"_distributions.csv",
+			os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+
+		err = writeDistributions(r.distributions[path], r.referenceIsLowerBound, csvFileDistributions)
+		_ = csvFileDistributions.Close()
+
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -169,21 +480,59 @@ func pathToUnderscoreValue(path string) string {
 	return strings.Trim(strings.Join(strings.Split(path, "/"), "_"), "_")
 }
 
-func writeAvgErrors(optimizersToAvgErrors OptimizersToAvgErrors, w io.Writer) error {
+func writeAvgErrors(
+	optimizersToAvgErrors OptimizersToAvgErrors,
+	optimizersToRuntimes OptimizersToRuntimes,
+	optimizersToFailureRates OptimizersToFailureRates,
+	referenceIsLowerBound bool,
+	w io.Writer,
+) error {
 	writer := csv.NewWriter(w)
 	defer writer.Flush()
 
-	header := []string{"optimizer", "average absolute error", "average relative error"}
+	kind := errorKind(referenceIsLowerBound)
+	header := []string{
+		"optimizer",
+		"average absolute " + kind,
+		"average relative " + kind,
+		"average runtime [ms]",
+		"median runtime [ms]",
+		"p95 runtime [ms]",
+		"failure rate",
+		"infeasible",
+		"errors",
+		"timeouts",
+	}
 
 	if err := writer.Write(header); err != nil {
 		return err
 	}
 
-	for optimizer, avgErr := range optimizersToAvgErrors {
+	for optimizer, failureRate := range optimizersToFailureRates {
+		absoluteError, relativeError := "", ""
+		if avgErr, ok := optimizersToAvgErrors[optimizer]; ok {
+			absoluteError = strconv.FormatFloat(avgErr.AvgAbsolutError, 'f', 3, 64)
+			relativeError = strconv.FormatFloat(avgErr.AvgRelativeError, 'f', 3, 64)
+		}
+
+		average, median, p95 := "", "", ""
+		if runtime, ok := optimizersToRuntimes[optimizer]; ok {
+			average = formatMilliseconds(runtime.Average)
+			median = formatMilliseconds(runtime.Median)
+			p95 = formatMilliseconds(runtime.P95)
+		}
+
 		err := writer.Write([]string{
 			optimizer,
-			strconv.FormatFloat(avgErr.AvgAbsolutError, 'f', 3, 64),
-			strconv.FormatFloat(avgErr.AvgRelativeError, 'f', 3, 64),
+			absoluteError,
+			relativeError,
+			average,
+			median,
+			p95,
+			strconv.FormatFloat(failureRate.Rate(), 'f', 3, 64),
+			strconv.Itoa(failureRate.Infeasible),
+			strconv.Itoa(failureRate.Errors),
+			strconv.Itoa(failureRate.Timeouts),
 		})
 
 		if err != nil {
@@ -194,28 +543,63 @@ func writeAvgErrors(optimizersToAvgErrors OptimizersToAvgErrors, w io.Writer) er
 	return nil
 }
 
-func writeErrors(filesToErrors FilesToErrors, w io.Writer) error {
+func writeErrors(
+	filesToErrors FilesToErrors,
+	filesToTimings FilesToTimings,
+	filesToOutcomes FilesToOutcomes,
+	referenceName string,
+	referenceIsLowerBound bool,
+	w io.Writer,
+) error {
 	writer := csv.NewWriter(w)
 	defer writer.Flush()
 
-	header := []string{"filename", "optimizer", "value", "optimal value", "absolute error", "relative error"}
+	kind := errorKind(referenceIsLowerBound)
+	header := []string{
+		"filename",
+		"optimizer",
+		"outcome",
+		"value",
+		referenceValueKind(referenceIsLowerBound),
+		"absolute " + kind,
+		"relative " + kind,
+		"wall time [ms]",
+		"cpu time [ms]",
+	}
 
 	if err := writer.Write(header); err != nil {
 		return err
 	}
 
-	for filename, optimizersToErrors := range filesToErrors {
-		for optimizer, errorInfo := range optimizersToErrors {
-			err := writer.Write([]string{
-				filename,
-				optimizer,
-				strconv.Itoa(errorInfo.Value),
-				strconv.Itoa(errorInfo.ReferenceValue),
-				strconv.Itoa(errorInfo.AbsoluteError),
-				strconv.FormatFloat(errorInfo.RelativeError, 'f', 3, 64),
-			})
+	for filename, optimizersToOutcomes := range filesToOutcomes {
+		for optimizer, outcome := range optimizersToOutcomes {
+			if optimizer == referenceName && outcome == runner.OutcomeSuccess {
+				continue
+			}
+
+			record := []string{filename, optimizer, outcome.String(), "", "", "", "", "", ""}
 
-			if err != nil {
+			if errorInfo, ok := filesToErrors[filename][optimizer]; ok {
+				t := filesToTimings[filename][optimizer]
+				cpuTime := ""
+				if t.CPUTime > 0 {
+					cpuTime = formatMilliseconds(t.CPUTime)
+				}
+
+				record = []string{
+					filename,
+					optimizer,
+					outcome.String(),
+					strconv.Itoa(errorInfo.Value),
+					strconv.Itoa(errorInfo.ReferenceValue),
+					strconv.Itoa(errorInfo.AbsoluteError),
+					strconv.FormatFloat(errorInfo.RelativeError, 'f', 3, 64),
+					formatMilliseconds(t.WallTime),
+					cpuTime,
+				}
+			}
+
+			if err := writer.Write(record); err != nil {
 				return err
 			}
 		}
@@ -223,3 +607,84 @@ func writeErrors(filesToErrors FilesToErrors, w io.Writer) error {
 
 	return nil
 }
+
+func writeDistributions(filesToDistributions FilesToDistributions, referenceIsLowerBound bool, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	defer writer.Flush()
+
+	kind := errorKind(referenceIsLowerBound)
+	header := []string{
+		"filename",
+		"optimizer",
+		"runs",
+		"min rrh count",
+		"mean rrh count",
+		"max rrh count",
+		"std rrh count",
+		"min relative " + kind,
+		"mean relative " + kind,
+		"max relative " + kind,
+		"std relative " + kind,
+	}
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for filename, optimizersToDistributions := range filesToDistributions {
+		for optimizer, d := range optimizersToDistributions {
+			record := append([]string{filename, optimizer, strconv.Itoa(d.Runs)}, formatDistributionFields(&d.RRHCount)...)
+			record = append(record, formatDistributionFields(d.RelativeError)...)
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatDistributionFields formats the distribution as CSV fields. Missing distribution results in empty fields.
+func formatDistributionFields(d *distribution.Distribution) []string {
+	if d == nil {
+		return []string{"", "", "", ""}
+	}
+
+	return []string{
+		strconv.FormatFloat(d.Min, 'f', 3, 64),
+		strconv.FormatFloat(d.Mean, 'f', 3, 64),
+		strconv.FormatFloat(d.Max, 'f', 3, 64),
+		strconv.FormatFloat(d.StdDev, 'f', 3, 64),
+	}
+}
+
+func formatMilliseconds(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', 3, 64)
+}
+
+// formatCPUTime formats CPU time for console output. CPU time is not measured for all executors,
+// so missing values are marked with a dash.
+func formatCPUTime(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	return formatMilliseconds(d)
+}
+
+// errorKind returns the name of differences between values of optimizers and values of the reference.
+// Differences to lower bounds are gaps, since they only limit errors from above.
+func errorKind(referenceIsLowerBound bool) string {
+	if referenceIsLowerBound {
+		return "gap"
+	}
+	return "error"
+}
+
+// referenceValueKind returns the name of values of the reference.
+func referenceValueKind(referenceIsLowerBound bool) string {
+	if referenceIsLowerBound {
+		return "lower bound"
+	}
+	return "optimal value"
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/distribution"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/errors"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,17 +22,37 @@ func Test_toErrors(t *testing.T) {
 
 		results := runner.PathsToResults{
 			"/path/1": runner.FilesToResults{
-				"file1": runner.OptimizersToResults{config.CPLEXOptimizerName: 2, "opt1": 3, "opt4": 13},
-				"file2": runner.OptimizersToResults{config.CPLEXOptimizerName: 4, "opt1": 5, "opt4": 12},
-				"file3": runner.OptimizersToResults{config.CPLEXOptimizerName: 5, "opt1": 12, "opt4": 32},
+				"file1": runner.OptimizersToResults{
+					config.CPLEXOptimizerName: {Value: 2},
+					"opt1":                    {Value: 3},
+					"opt4":                    {Value: 13},
+				},
+				"file2": runner.OptimizersToResults{
+					config.CPLEXOptimizerName: {Value: 4},
+					"opt1":                    {Value: 5},
+					"opt4":                    {Value: 12},
+				},
+				"file3": runner.OptimizersToResults{
+					config.CPLEXOptimizerName: {Value: 5},
+					"opt1":                    {Value: 12},
+					"opt4":                    {Value: 32},
+				},
 			},
 			"/path/2": runner.FilesToResults{
-				"file4": runner.OptimizersToResults{config.CPLEXOptimizerName: 12, "opt1": 23, "opt4": 53},
-				"file5": runner.OptimizersToResults{config.CPLEXOptimizerName: 14, "opt1": 35, "opt4": 22},
+				"file4": runner.OptimizersToResults{
+					config.CPLEXOptimizerName: {Value: 12},
+					"opt1":                    {Value: 23},
+					"opt4":                    {Value: 53},
+				},
+				"file5": runner.OptimizersToResults{
+					config.CPLEXOptimizerName: {Value: 14},
+					"opt1":                    {Value: 35},
+					"opt4":                    {Value: 22},
+				},
 			},
 		}
 
-		errs := toErrors(results)
+		errs := toErrors(results, config.CPLEXOptimizerName, false)
 
 		assert.Len(t, errs, 2)
 		assert.Contains(t, errs, "/path/1")
@@ -51,6 +74,158 @@ func Test_toErrors(t *testing.T) {
 		assert.Len(t, errs["/path/2"]["file4"], 2)
 		assert.Len(t, errs["/path/2"]["file5"], 2)
 	})
+
+	t.Run("should compute errors against given reference", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{"reference": {Value: 2}, "opt1": {Value: 3}},
+			},
+		}
+
+		errs := toErrors(results, "reference", false)
+
+		expected := OptimizersToErrors{"opt1": *errors.Calculate(errors.Reference{Value: 2}, 3)}
+		assert.Equal(t, expected, errs["/path/1"]["file1"])
+	})
+}
+
+func Test_toErrors_failures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should skip failed runs of optimizers", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"reference": {Value: 2},
+					"opt1":      {Value: 3},
+					"opt2":      {Outcome: runner.OutcomeInfeasible},
+				},
+			},
+		}
+
+		errs := toErrors(results, "reference", false)
+
+		expected := OptimizersToErrors{"opt1": *errors.Calculate(errors.Reference{Value: 2}, 3)}
+		assert.Equal(t, expected, errs["/path/1"]["file1"])
+	})
+
+	t.Run("should skip files for which reference failed", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"reference": {Outcome: runner.OutcomeTimeout},
+					"opt1":      {Value: 3},
+				},
+				"file2": runner.OptimizersToResults{
+					"reference": {Value: 4},
+					"opt1":      {Value: 4},
+				},
+			},
+		}
+
+		errs := toErrors(results, "reference", false)
+
+		expected := FilesToErrors{"file2": OptimizersToErrors{"opt1": *errors.Calculate(errors.Reference{Value: 4}, 4)}}
+		assert.Equal(t, expected, errs["/path/1"])
+	})
+}
+
+func Test_toDistributions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should describe samples of repeated runs", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"reference": {Value: 4},
+					"opt1":      {Value: 3},
+					"opt2":      {Value: 4, Samples: []int{4, 6, 5}},
+					"opt3":      {Outcome: runner.OutcomeError},
+				},
+			},
+		}
+
+		distributions := toDistributions(results, "reference", false)
+
+		expectedRelativeError := distribution.Describe([]float64{0, 0.5, 0.25})
+		assert.Equal(t, FilesToDistributions{
+			"file1": OptimizersToDistributions{
+				"opt2": SampleDistribution{
+					Runs:          3,
+					RRHCount:      distribution.DescribeInts([]int{4, 6, 5}),
+					RelativeError: &expectedRelativeError,
+				},
+			},
+		}, distributions["/path/1"])
+	})
+
+	t.Run("should skip relative error if reference failed", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"reference": {Outcome: runner.OutcomeTimeout},
+					"opt1":      {Value: 4, Samples: []int{4, 6}},
+				},
+			},
+		}
+
+		distributions := toDistributions(results, "reference", false)
+
+		assert.Equal(t, 2, distributions["/path/1"]["file1"]["opt1"].Runs)
+		assert.Nil(t, distributions["/path/1"]["file1"]["opt1"].RelativeError)
+	})
+}
+
+func Test_toFailureRates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should count outcomes per path and optimizer", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"opt1": {Value: 3},
+					"opt2": {Outcome: runner.OutcomeInfeasible},
+				},
+				"file2": runner.OptimizersToResults{
+					"opt1": {Outcome: runner.OutcomeTimeout},
+					"opt2": {Outcome: runner.OutcomeError},
+				},
+			},
+			"/path/2": runner.FilesToResults{
+				"file3": runner.OptimizersToResults{
+					"opt1": {Value: 1},
+				},
+			},
+		}
+
+		failureRates := toFailureRates(toOutcomes(results))
+
+		assert.Equal(t, PathsToFailureRates{
+			"/path/1": OptimizersToFailureRates{
+				"opt1": FailureRate{Runs: 2, Timeouts: 1},
+				"opt2": FailureRate{Runs: 2, Infeasible: 1, Errors: 1},
+			},
+			"/path/2": OptimizersToFailureRates{
+				"opt1": FailureRate{Runs: 1},
+			},
+		}, failureRates)
+
+		assert.InDelta(t, 0.5, failureRates["/path/1"]["opt1"].Rate(), 1e-9)
+		assert.InDelta(t, 1.0, failureRates["/path/1"]["opt2"].Rate(), 1e-9)
+		assert.Zero(t, failureRates["/path/2"]["opt1"].Rate())
+	})
 }
 
 func Test_toAverageErrors(t *testing.T) {
@@ -102,6 +277,93 @@ func Test_toAverageErrors(t *testing.T) {
 	})
 }
 
+func Test_toTimings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should skip timings of failed runs", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"opt1": {Value: 3, Timing: timing.Timing{WallTime: time.Millisecond}},
+					"opt2": {Outcome: runner.OutcomeError},
+				},
+			},
+		}
+
+		timings := toTimings(results)
+
+		assert.Equal(t, OptimizersToTimings{"opt1": timing.Timing{WallTime: time.Millisecond}}, timings["/path/1"]["file1"])
+	})
+
+	t.Run("should extract timings from results", func(t *testing.T) {
+		t.Parallel()
+
+		results := runner.PathsToResults{
+			"/path/1": runner.FilesToResults{
+				"file1": runner.OptimizersToResults{
+					"reference": {Value: 2, Timing: timing.Timing{WallTime: time.Second, CPUTime: 2 * time.Second}},
+					"opt1":      {Value: 3, Timing: timing.Timing{WallTime: time.Millisecond}},
+				},
+			},
+		}
+
+		timings := toTimings(results)
+
+		assert.Equal(t, PathsToTimings{
+			"/path/1": FilesToTimings{
+				"file1": OptimizersToTimings{
+					"reference": timing.Timing{WallTime: time.Second, CPUTime: 2 * time.Second},
+					"opt1":      timing.Timing{WallTime: time.Millisecond},
+				},
+			},
+		}, timings)
+	})
+}
+
+func Test_toRuntimes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should summarize wall times per path and optimizer", func(t *testing.T) {
+		t.Parallel()
+
+		pathsToTimings := PathsToTimings{
+			"/path/1": FilesToTimings{
+				"file1": OptimizersToTimings{
+					"opt1": timing.Timing{WallTime: 1 * time.Millisecond},
+					"opt2": timing.Timing{WallTime: 10 * time.Millisecond},
+				},
+				"file2": OptimizersToTimings{
+					"opt1": timing.Timing{WallTime: 3 * time.Millisecond},
+					"opt2": timing.Timing{WallTime: 20 * time.Millisecond},
+				},
+				"file3": OptimizersToTimings{
+					"opt1": timing.Timing{WallTime: 2 * time.Millisecond},
+					"opt2": timing.Timing{WallTime: 60 * time.Millisecond},
+				},
+			},
+			"/path/2": FilesToTimings{
+				"file4": OptimizersToTimings{
+					"opt1": timing.Timing{WallTime: 5 * time.Millisecond},
+				},
+			},
+		}
+
+		runtimes := toRuntimes(pathsToTimings)
+
+		assert.Equal(t, PathsToRuntimes{
+			"/path/1": OptimizersToRuntimes{
+				"opt1": timing.Summary{Average: 2 * time.Millisecond, Median: 2 * time.Millisecond, P95: 3 * time.Millisecond},
+				"opt2": timing.Summary{Average: 30 * time.Millisecond, Median: 20 * time.Millisecond, P95: 60 * time.Millisecond},
+			},
+			"/path/2": OptimizersToRuntimes{
+				"opt1": timing.Summary{Average: 5 * time.Millisecond, Median: 5 * time.Millisecond, P95: 5 * time.Millisecond},
+			},
+		}, runtimes)
+	})
+}
+
 func Test_pathToUnderscoreValue(t *testing.T) {
 	t.Parallel()
 
@@ -138,31 +400,48 @@ func Test_pathToUnderscoreValue(t *testing.T) {
 func Test_writeAvgErrors(t *testing.T) {
 	t.Parallel()
 
-	expectedHeader := "optimizer,average absolute error,average relative error"
+	expectedHeader := "optimizer,average absolute error,average relative error," +
+		"average runtime [ms],median runtime [ms],p95 runtime [ms],failure rate,infeasible,errors,timeouts"
 
 	optToAvgErr := OptimizersToAvgErrors{
 		"opt1": AvgErrors{1, 3},
 		"opt2": AvgErrors{3.3, 2.5},
 	}
 
+	optToRuntimes := OptimizersToRuntimes{
+		"reference": timing.Summary{Average: 3 * time.Second, Median: 2 * time.Second, P95: 5 * time.Second},
+		"opt1":      timing.Summary{Average: 1500 * time.Microsecond, Median: time.Millisecond, P95: 4 * time.Millisecond},
+		"opt2":      timing.Summary{Average: 2 * time.Millisecond, Median: 2 * time.Millisecond, P95: 2 * time.Millisecond},
+	}
+
+	optToFailureRates := OptimizersToFailureRates{
+		"reference": FailureRate{Runs: 4},
+		"opt1":      FailureRate{Runs: 4, Infeasible: 1},
+		"opt2":      FailureRate{Runs: 4, Errors: 1, Timeouts: 1},
+		"opt3":      FailureRate{Runs: 4, Infeasible: 4},
+	}
+
 	var buffer bytes.Buffer
 
-	err := writeAvgErrors(optToAvgErr, &buffer)
+	err := writeAvgErrors(optToAvgErr, optToRuntimes, optToFailureRates, false, &buffer)
 	assert.NoError(t, err)
 
 	s := buffer.String()
 	lines := strings.Split(s, "\n")
 
-	assert.Len(t, lines, 4)
+	assert.Len(t, lines, 6)
 	assert.Equal(t, expectedHeader, lines[0])
-	assert.Contains(t, lines, "opt1,3.000,1.000")
-	assert.Contains(t, lines, "opt2,2.500,3.300")
+	assert.Contains(t, lines, "reference,,,3000.000,2000.000,5000.000,0.000,0,0,0")
+	assert.Contains(t, lines, "opt1,3.000,1.000,1.500,1.000,4.000,0.250,1,0,0")
+	assert.Contains(t, lines, "opt2,2.500,3.300,2.000,2.000,2.000,0.500,0,1,1")
+	assert.Contains(t, lines, "opt3,,,,,,1.000,4,0,0")
 }
 
 func Test_writeErrors(t *testing.T) {
 	t.Parallel()
 
-	expectedHeader := "filename,optimizer,value,optimal value,absolute error,relative error"
+	expectedHeader := "filename,optimizer,outcome,value,optimal value,absolute error,relative error," +
+		"wall time [ms],cpu time [ms]"
 
 	filesToErrs := FilesToErrors{
 		"file1": OptimizersToErrors{
@@ -174,23 +453,103 @@ func Test_writeErrors(t *testing.T) {
 		},
 	}
 
+	filesToTimings := FilesToTimings{
+		"file1": OptimizersToTimings{
+			"opt1": timing.Timing{WallTime: time.Millisecond, CPUTime: 2 * time.Millisecond},
+			"opt2": timing.Timing{WallTime: 3 * time.Millisecond}},
+		"file2": OptimizersToTimings{
+			"opt1": timing.Timing{WallTime: 5 * time.Millisecond, CPUTime: 4 * time.Millisecond},
+			"opt2": timing.Timing{WallTime: 1500 * time.Microsecond},
+		},
+	}
+
+	filesToOutcomes := FilesToOutcomes{
+		"file1": OptimizersToOutcomes{
+			"reference": runner.OutcomeSuccess,
+			"opt1":      runner.OutcomeSuccess,
+			"opt2":      runner.OutcomeSuccess,
+			"opt3":      runner.OutcomeInfeasible,
+		},
+		"file2": OptimizersToOutcomes{
+			"reference": runner.OutcomeSuccess,
+			"opt1":      runner.OutcomeSuccess,
+			"opt2":      runner.OutcomeSuccess,
+			"opt3":      runner.OutcomeTimeout,
+		},
+		"file3": OptimizersToOutcomes{
+			"reference": runner.OutcomeError,
+			"opt1":      runner.OutcomeSuccess,
+		},
+	}
+
 	var buffer bytes.Buffer
 
-	err := writeErrors(filesToErrs, &buffer)
+	err := writeErrors(filesToErrs, filesToTimings, filesToOutcomes, "reference", false, &buffer)
 	assert.NoError(t, err)
 
 	s := buffer.String()
 	lines := strings.Split(s, "\n")
 
-	assert.Len(t, lines, 6)
+	assert.Len(t, lines, 10)
 	assert.Equal(t, expectedHeader, lines[0])
-	assert.Contains(t, lines, "file1,opt1,1,2,1,0.500")
-	assert.Contains(t, lines, "file1,opt2,3,6,3,0.500")
-	assert.Contains(t, lines, "file2,opt1,5,10,5,0.500")
-	assert.Contains(t, lines, "file2,opt2,12,6,6,1.000")
+	assert.Contains(t, lines, "file1,opt1,success,1,2,1,0.500,1.000,2.000")
+	assert.Contains(t, lines, "file1,opt2,success,3,6,3,0.500,3.000,")
+	assert.Contains(t, lines, "file1,opt3,infeasible,,,,,,")
+	assert.Contains(t, lines, "file2,opt1,success,5,10,5,0.500,5.000,4.000")
+	assert.Contains(t, lines, "file2,opt2,success,12,6,6,1.000,1.500,")
+	assert.Contains(t, lines, "file2,opt3,timeout,,,,,,")
+	assert.Contains(t, lines, "file3,reference,error,,,,,,")
+	assert.Contains(t, lines, "file3,opt1,success,,,,,,")
 }
 
 func assertErrorWithinDelta(t *testing.T, expected, given AvgErrors) {
 	assert.InDelta(t, expected.AvgRelativeError, given.AvgRelativeError, 0.1)
 	assert.InDelta(t, expected.AvgAbsolutError, given.AvgAbsolutError, 0.1)
 }
+
+func Test_writeDistributions(t *testing.T) {
+	t.Parallel()
+
+	expectedHeader := "filename,optimizer,runs,min rrh count,mean rrh count,max rrh count,std rrh count," +
+		"min relative error,mean relative error,max relative error,std relative error"
+
+	relativeError := distribution.Distribution{Min: 0, Mean: 0.25, Max: 0.5, StdDev: 0.2}
+
+	filesToDistributions := FilesToDistributions{
+		"file1": OptimizersToDistributions{
+			"opt1": SampleDistribution{
+				Runs:          3,
+				RRHCount:      distribution.Distribution{Min: 4, Mean: 5, Max: 6, StdDev: 0.816},
+				RelativeError: &relativeError,
+			},
+		},
+		"file2": OptimizersToDistributions{
+			"opt1": SampleDistribution{Runs: 2, RRHCount: distribution.Distribution{Min: 3, Mean: 3, Max: 3}},
+		},
+	}
+
+	var buffer bytes.Buffer
+
+	err := writeDistributions(filesToDistributions, false, &buffer)
+	assert.NoError(t, err)
+
+	lines := strings.Split(buffer.String(), "\n")
+
+	assert.Len(t, lines, 4)
+	assert.Equal(t, expectedHeader, lines[0])
+	assert.Contains(t, lines, "file1,opt1,3,4.000,5.000,6.000,0.816,0.000,0.250,0.500,0.200")
+	assert.Contains(t, lines, "file2,opt1,2,3.000,3.000,3.000,0.000,,,,")
+}
+
+func Test_writeErrors_lowerBound(t *testing.T) {
+	t.Parallel()
+
+	expectedHeader := "filename,optimizer,outcome,value,lower bound,absolute gap,relative gap,wall time [ms],cpu time [ms]"
+
+	var buffer bytes.Buffer
+
+	err := writeErrors(FilesToErrors{}, FilesToTimings{}, FilesToOutcomes{}, "reference", true, &buffer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expectedHeader, strings.Split(buffer.String(), "\n")[0])
+}
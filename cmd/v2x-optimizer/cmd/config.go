@@ -7,22 +7,38 @@ import (
 	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
 )
 
+// encoderInfo describes formats to which data can be both encoded and decoded.
+// StoresMetadata is set if the format preserves data.Metadata.
 type encoderInfo struct {
 	FormatDisplayName string
 	Encoder           data.EncoderDecoder
+	StoresMetadata    bool
+}
+
+// exportEncoderInfo describes export-only formats, i.e. the ones to which data can be encoded only.
+type exportEncoderInfo struct {
+	FormatDisplayName string
+	Encoder           data.Encoder
 }
 
 const (
 	jsonFormat  = "json"
 	plainFormat = "plain"
 	cplexFormat = "cplex"
+	lpFormat    = "lp"
+	mpsFormat   = "mps"
 )
 
 var (
 	formatsToEncodersInfo = map[string]encoderInfo{
-		jsonFormat:  {"json", encoder.JSON{}},
-		plainFormat: {"plain (CSV-like)", encoder.Plain{}},
-		cplexFormat: {"CPLEX", encoder.CPLEX{}},
+		jsonFormat:  {"json", encoder.JSON{}, true},
+		plainFormat: {"plain (CSV-like)", encoder.Plain{}, false},
+		cplexFormat: {"CPLEX", encoder.CPLEX{}, false},
+	}
+
+	formatsToExportEncodersInfo = map[string]exportEncoderInfo{
+		lpFormat:  {"LP", encoder.LP{}},
+		mpsFormat: {"free MPS", encoder.MPS{}},
 	}
 
 	availableFileFormats = getAvailableFileFormats()
@@ -32,6 +48,7 @@ var (
 	errCannotCreatePath  = errors.New("cannot create path")
 	errCannotOpenFile    = errors.New("cannot open file")
 	errCannotParseData   = errors.New("cannot parse data")
+	errCannotParseResult = errors.New("cannot parse result")
 	errCannotEncodeData  = errors.New("cannot encode data")
 	errUnknownDataFormat = errors.New("unknown data format")
 )
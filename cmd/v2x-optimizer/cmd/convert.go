@@ -28,7 +28,12 @@ func ConvertCmd() *cobra.Command {
 				continue
 			}
 
-			convertToCmd := convertTo(encodedFormat, decoderInfo, encoderInfo)
+			convertToCmd := convertTo(encodedFormat, encoderInfo.FormatDisplayName, decoderInfo.Encoder, encoderInfo.Encoder)
+			convertFromCmd.AddCommand(convertToCmd)
+		}
+
+		for encodedFormat, encoderInfo := range formatsToExportEncodersInfo {
+			convertToCmd := convertTo(encodedFormat, encoderInfo.FormatDisplayName, decoderInfo.Encoder, encoderInfo.Encoder)
 			convertFromCmd.AddCommand(convertToCmd)
 		}
 
@@ -49,17 +54,17 @@ func convertFrom(formatName string, encoderInfo encoderInfo) *cobra.Command {
 	}
 }
 
-func convertTo(formatName string, decoder, encoder encoderInfo) *cobra.Command {
+func convertTo(formatName, formatDisplayName string, decoder data.Decoder, encoder data.Encoder) *cobra.Command {
 	return &cobra.Command{
 		Use:   fmt.Sprintf("%s {input_file} {output_file}", formatName),
 		Args:  cobra.ExactArgs(2),
-		Short: fmt.Sprintf("Convert data to %s format", encoder.FormatDisplayName),
-		Long:  fmt.Sprintf("Allows for converting data to %s format", encoder.FormatDisplayName),
-		RunE:  convertWith(decoder.Encoder, encoder.Encoder),
+		Short: fmt.Sprintf("Convert data to %s format", formatDisplayName),
+		Long:  fmt.Sprintf("Allows for converting data to %s format", formatDisplayName),
+		RunE:  convertWith(decoder, encoder),
 	}
 }
 
-func convertWith(decoder, encoder data.EncoderDecoder) func(*cobra.Command, []string) error {
+func convertWith(decoder data.Decoder, encoder data.Encoder) func(*cobra.Command, []string) error {
 	return func(command *cobra.Command, args []string) error {
 		input, output := args[0], args[1]
 
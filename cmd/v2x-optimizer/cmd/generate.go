@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
 	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
@@ -20,16 +22,38 @@ const (
 	constantBucketSizeValue = "constant-bucket-size"
 	countValue              = "count"
 	kindValue               = "kind"
+	seedValue               = "seed"
 
 	exponentialKind = "exponential"
 	normalKind      = "normal"
 	uniformKind     = "uniform"
 	v2xKind         = "v2x"
 
-	outputFilePattern = "data_%d.v2x"
+	outputFilePattern   = "data_%d.v2x"
+	metadataFilePattern = ".data_%d.meta.json"
 )
 
-type generateFunc func(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data
+type generateFunc func(g *generator.Generator, itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data
+
+// generationMetadata allows for generating exactly the same data again. It is stored as parameters of data.Metadata
+// if the format preserves it, or in the hidden file next to the generated file otherwise.
+type generationMetadata struct {
+	Seed               int64  `json:"seed"`
+	Kind               string `json:"kind"`
+	ItemCount          uint   `json:"items"`
+	ItemSize           uint   `json:"item_size"`
+	BucketCount        uint   `json:"buckets"`
+	BucketSize         uint   `json:"bucket_size"`
+	ConstantBucketSize bool   `json:"constant_bucket_size"`
+	// RadioModel is stored only if the radio model of v2x generator is given by the user.
+	RadioModel *radioModelConfig `json:"radio_model,omitempty"`
+	// Layout is stored only if a layout of stations other than the default one is given by the user.
+	Layout *layoutConfig `json:"layout,omitempty"`
+	// Vehicles are stored only if a distribution of vehicles other than the uniform one is given by the user.
+	Vehicles *vehicleDistributionConfig `json:"vehicles,omitempty"`
+	// Geometry is stored only in the hidden file if the generator places vehicles and stations.
+	Geometry *data.Geometry `json:"geometry,omitempty"`
+}
 
 // GenerateCmd returns cobra.Command which is able to generate data in specified format.
 // It should be registered in root command using AddCommand() method.
@@ -58,11 +82,11 @@ func generateTo(formatName string, encoderInfo encoderInfo) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: fmt.Sprintf("Generate data in %s format", encoderInfo.FormatDisplayName),
 		Long:  fmt.Sprintf("Allows for generating data in %s format", encoderInfo.FormatDisplayName),
-		RunE:  generateWith(encoderInfo.Encoder),
+		RunE:  generateWith(encoderInfo.Encoder, encoderInfo.StoresMetadata),
 	}
 }
 
-func generateWith(encoder data.EncoderDecoder) func(*cobra.Command, []string) error {
+func generateWith(encoder data.EncoderDecoder, storesMetadata bool) func(*cobra.Command, []string) error {
 	return func(command *cobra.Command, args []string) error {
 		output := args[0]
 
@@ -96,7 +120,24 @@ func generateWith(encoder data.EncoderDecoder) func(*cobra.Command, []string) er
 			return err
 		}
 
-		generate := toGeneratorFunc(kind, isBucketSizeConstant)
+		radioModel, radioModelConfig, err := getRadioModel(command)
+		if err != nil {
+			return err
+		}
+
+		layout, layoutConfig, err := getStationLayout(command)
+		if err != nil {
+			return err
+		}
+
+		vehicles, vehiclesConfig, err := getVehicleDistribution(command)
+		if err != nil {
+			return err
+		}
+
+		v2xConfig := generator.V2XConfig{Radio: radioModel, Layout: layout, Vehicles: vehicles}
+
+		generate := toGeneratorFunc(kind, isBucketSizeConstant, v2xConfig)
 		if generate == nil {
 			return errors.New("unknown kind")
 		}
@@ -106,8 +147,26 @@ func generateWith(encoder data.EncoderDecoder) func(*cobra.Command, []string) er
 			return err
 		}
 
+		seed, err := getSeed(command)
+		if err != nil {
+			return err
+		}
+
 		for i := uint(0); i < count; i++ {
-			err := generateDataFile(output, int(i), encoder, itemCount, itemSize, bucketCount, bucketSize, generate)
+			metadata := generationMetadata{
+				Seed:               seed + int64(i),
+				Kind:               kind,
+				ItemCount:          itemCount,
+				ItemSize:           itemSize,
+				BucketCount:        bucketCount,
+				BucketSize:         bucketSize,
+				ConstantBucketSize: isBucketSizeConstant,
+				RadioModel:         radioModelConfig,
+				Layout:             layoutConfig,
+				Vehicles:           vehiclesConfig,
+			}
+
+			err := generateDataFile(output, int(i), encoder, storesMetadata, metadata, generate)
 			if err != nil {
 				return err
 			}
@@ -121,7 +180,8 @@ func generateDataFile(
 	outputPath string,
 	fileID int,
 	encoder data.EncoderDecoder,
-	itemCount, itemSize, bucketCount, bucketSize uint,
+	storesMetadata bool,
+	metadata generationMetadata,
 	generate generateFunc,
 ) error {
 	err := os.MkdirAll(outputPath, 0775)
@@ -142,34 +202,100 @@ func generateDataFile(
 	}
 	defer outputFile.Close()
 
-	generatedData := generate(int(itemCount), int(itemSize), int(bucketCount), int(bucketSize))
+	generatedData := generate(
+		generator.NewWithSeed(metadata.Seed),
+		int(metadata.ItemCount),
+		int(metadata.ItemSize),
+		int(metadata.BucketCount),
+		int(metadata.BucketSize),
+	)
+
+	if storesMetadata {
+		err = attachMetadata(generatedData, metadata)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = encoder.Encode(generatedData, outputFile)
 	if err != nil {
 		return fmt.Errorf("%w: %s", errCannotEncodeData, err.Error())
 	}
 
+	if storesMetadata {
+		return nil
+	}
+
+	if generatedData.Metadata != nil {
+		metadata.Geometry = generatedData.Metadata.Geometry
+	}
+
+	return writeMetadataFile(path.Join(outputPath, fmt.Sprintf(metadataFilePattern, fileID)), metadata)
+}
+
+// attachMetadata stores the generation metadata as parameters of data.Metadata.
+func attachMetadata(generatedData *data.Data, metadata generationMetadata) error {
+	parameters, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	if generatedData.Metadata == nil {
+		generatedData.Metadata = &data.Metadata{}
+	}
+
+	generatedData.Metadata.Parameters = parameters
+
 	return nil
 }
 
-func toGeneratorFunc(kind string, isBucketSizeConstant bool) generateFunc {
+func writeMetadataFile(filePath string, metadata generationMetadata) error {
+	metadataFile, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errCannotOpenFile, filePath)
+	}
+	defer metadataFile.Close()
+
+	jsonEncoder := json.NewEncoder(metadataFile)
+	jsonEncoder.SetIndent("", "  ")
+
+	return jsonEncoder.Encode(metadata)
+}
+
+// getSeed returns the seed given by the user or a seed based on the current time if the user has not specified it.
+func getSeed(command *cobra.Command) (int64, error) {
+	if !command.Flags().Changed(seedValue) {
+		return time.Now().UnixNano(), nil
+	}
+
+	return command.Flags().GetInt64(seedValue)
+}
+
+// toGeneratorFunc returns generateFunc of the given kind. The radio model, the layout and the vehicle
+// distribution of v2xConfig are used only by v2x kind.
+func toGeneratorFunc(kind string, isBucketSizeConstant bool, v2xConfig generator.V2XConfig) generateFunc {
 	switch {
 	case kind == uniformKind && isBucketSizeConstant:
-		return generator.GenerateUniformConstantBucketSize
+		return (*generator.Generator).GenerateUniformConstantBucketSize
 	case kind == uniformKind && !isBucketSizeConstant:
-		return generator.GenerateUniform
+		return (*generator.Generator).GenerateUniform
 	case kind == exponentialKind && isBucketSizeConstant:
-		return generator.GenerateExponentialConstantBucketSize
+		return (*generator.Generator).GenerateExponentialConstantBucketSize
 	case kind == exponentialKind && !isBucketSizeConstant:
-		return generator.GenerateExponential
+		return (*generator.Generator).GenerateExponential
 	case kind == normalKind && isBucketSizeConstant:
-		return generator.GenerateNormalConstantBucketSize
+		return (*generator.Generator).GenerateNormalConstantBucketSize
 	case kind == normalKind && !isBucketSizeConstant:
-		return generator.GenerateNormal
-	case kind == v2xKind && isBucketSizeConstant:
-		return generator.GenerateV2XEnvironmentalConstantBucketSize
-	case kind == v2xKind && !isBucketSizeConstant:
-		return generator.GenerateV2XEnvironmental
+		return (*generator.Generator).GenerateNormal
+	case kind == v2xKind:
+		return func(g *generator.Generator, itemCount, _, bucketCount, bucketSize int) *data.Data {
+			config := v2xConfig
+			config.VehicleCount = itemCount
+			config.BucketCount = bucketCount
+			config.BucketSize = bucketSize
+			config.ConstantBucketSize = isBucketSizeConstant
+			return g.GenerateV2X(config)
+		}
 	default:
 		return nil
 	}
@@ -186,4 +312,10 @@ func setUpGenerateFlags(command *cobra.Command) {
 		"disables random bucket sizes and enables constant size for all buckets")
 	command.Flags().UintP(countValue, "", 1, "specify how many files should be generated")
 	command.Flags().StringP(kindValue, "", "uniform", "specify generator kind (uniform, exponential, normal, v2x)")
+	command.Flags().Int64P(seedValue, "", 0, "seed of the first generated file, consecutive files use consecutive seeds"+
+		" (if not specified, the seed is based on the current time); seeds are stored in metadata of "+
+		jsonFormat+" files or in hidden "+metadataFilePattern+" files otherwise")
+	setUpRadioModelFlag(command)
+	setUpLayoutFlags(command)
+	setUpVehicleDistributionFlags(command)
 }
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+)
+
+const (
+	mobilityModelValue = "model"
+	stepCountValue     = "steps"
+	minSpeedValue      = "min-speed"
+	maxSpeedValue      = "max-speed"
+	laneCountValue     = "lanes"
+	blockCountValue    = "blocks"
+
+	randomWaypointModel = "random-waypoint"
+	highwayModel        = "highway"
+	manhattanModel      = "manhattan"
+)
+
+var mobilityModels = map[string]generator.MobilityModel{
+	randomWaypointModel: generator.RandomWaypoint,
+	highwayModel:        generator.Highway,
+	manhattanModel:      generator.Manhattan,
+}
+
+// scenarioGenerationMetadata allows for generating exactly the same scenario again.
+// It is stored as parameters of data.Scenario, which are preserved by both formats.
+type scenarioGenerationMetadata struct {
+	Seed               int64   `json:"seed"`
+	Model              string  `json:"model"`
+	VehicleCount       int     `json:"items"`
+	BucketCount        int     `json:"buckets"`
+	BucketSize         int     `json:"bucket_size"`
+	ConstantBucketSize bool    `json:"constant_bucket_size"`
+	Steps              int     `json:"steps"`
+	MinSpeed           float64 `json:"min_speed"`
+	MaxSpeed           float64 `json:"max_speed"`
+	LaneCount          int     `json:"lanes"`
+	BlockCount         int     `json:"blocks"`
+	// RadioModel is stored only if the radio model is given by the user.
+	RadioModel *radioModelConfig `json:"radio_model,omitempty"`
+	// Layout is stored only if a layout of stations other than the default one is given by the user.
+	Layout *layoutConfig `json:"layout,omitempty"`
+}
+
+var formatsToScenarioEncoders = map[string]func(s *data.Scenario, path string) error{
+	jsonFormat:  encodeJSONScenario,
+	cplexFormat: encoder.ScenarioDirectory{}.Encode,
+}
+
+// GenerateScenarioCmd returns cobra.Command which is able to generate scenarios of moving vehicles.
+// It should be registered in root command using AddCommand() method.
+func GenerateScenarioCmd() *cobra.Command {
+	generateScenarioCmd := &cobra.Command{
+		Use:   "generate-scenario",
+		Short: "Generate scenario of moving vehicles in given format",
+		Long: "Allows generating scenario of moving vehicles in given format. R is recomputed at each step" +
+			" the same way as by v2x kind of generate command",
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	for formatName, encode := range formatsToScenarioEncoders {
+		command := generateScenarioTo(formatName, encode)
+		setUpGenerateScenarioFlags(command)
+		generateScenarioCmd.AddCommand(command)
+	}
+
+	return generateScenarioCmd
+}
+
+func generateScenarioTo(formatName string, encode func(*data.Scenario, string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   fmt.Sprintf("%s {output_path}", formatName),
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("Generate scenario in %s format", formatName),
+		Long: fmt.Sprintf("Allows for generating scenario in %s format. The output_path is a file in %s format"+
+			" or a directory of snapshot files in %s format", formatName, jsonFormat, cplexFormat),
+		RunE: generateScenarioWith(encode),
+	}
+}
+
+func generateScenarioWith(encode func(*data.Scenario, string) error) func(*cobra.Command, []string) error {
+	return func(command *cobra.Command, args []string) error {
+		modelName, config, err := toMobilityConfig(command)
+		if err != nil {
+			return err
+		}
+
+		radioModel, radioModelConfig, err := getRadioModel(command)
+		if err != nil {
+			return err
+		}
+
+		layout, layoutConfig, err := getStationLayout(command)
+		if err != nil {
+			return err
+		}
+
+		config.Radio = radioModel
+		config.Layout = layout
+
+		seed, err := getSeed(command)
+		if err != nil {
+			return err
+		}
+
+		s := generator.NewWithSeed(seed).GenerateV2XMobility(config)
+
+		s.Parameters, err = json.Marshal(scenarioGenerationMetadata{
+			Seed:               seed,
+			Model:              modelName,
+			VehicleCount:       config.VehicleCount,
+			BucketCount:        config.BucketCount,
+			BucketSize:         config.BucketSize,
+			ConstantBucketSize: config.ConstantBucketSize,
+			Steps:              config.Steps,
+			MinSpeed:           config.MinSpeed,
+			MaxSpeed:           config.MaxSpeed,
+			LaneCount:          config.LaneCount,
+			BlockCount:         config.BlockCount,
+			RadioModel:         radioModelConfig,
+			Layout:             layoutConfig,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = encode(s, args[0])
+		if err != nil {
+			return fmt.Errorf("%w: %s", errCannotEncodeData, err.Error())
+		}
+
+		return nil
+	}
+}
+
+// toMobilityConfig returns the name of the mobility model and the config without the radio model and the layout.
+func toMobilityConfig(command *cobra.Command) (string, generator.MobilityConfig, error) {
+	modelName, err := command.Flags().GetString(mobilityModelValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	model, ok := mobilityModels[modelName]
+	if !ok {
+		return "", generator.MobilityConfig{}, fmt.Errorf("unknown mobility model: %s", modelName)
+	}
+
+	vehicleCount, err := command.Flags().GetUint(itemCountValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	bucketCount, err := command.Flags().GetUint(bucketCountValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	bucketSize, err := command.Flags().GetUint(bucketSizeValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	laneCount, err := command.Flags().GetUint(laneCountValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	blockCount, err := command.Flags().GetUint(blockCountValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	steps, err := command.Flags().GetUint(stepCountValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	isBucketSizeConstant, err := command.Flags().GetBool(constantBucketSizeValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	minSpeed, err := command.Flags().GetFloat64(minSpeedValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	maxSpeed, err := command.Flags().GetFloat64(maxSpeedValue)
+	if err != nil {
+		return "", generator.MobilityConfig{}, err
+	}
+
+	if minSpeed < 0 || maxSpeed < minSpeed {
+		return "", generator.MobilityConfig{}, fmt.Errorf("invalid speed range: [%v, %v]", minSpeed, maxSpeed)
+	}
+
+	if laneCount == 0 || blockCount == 0 {
+		return "", generator.MobilityConfig{}, fmt.Errorf("%s and %s have to be positive", laneCountValue, blockCountValue)
+	}
+
+	return modelName, generator.MobilityConfig{
+		Model:              model,
+		VehicleCount:       int(vehicleCount),
+		BucketCount:        int(bucketCount),
+		BucketSize:         int(bucketSize),
+		ConstantBucketSize: isBucketSizeConstant,
+		Steps:              int(steps),
+		MinSpeed:           minSpeed,
+		MaxSpeed:           maxSpeed,
+		LaneCount:          int(laneCount),
+		BlockCount:         int(blockCount),
+	}, nil
+}
+
+func encodeJSONScenario(s *data.Scenario, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return encoder.ScenarioJSON{}.Encode(s, file)
+}
+
+func setUpGenerateScenarioFlags(command *cobra.Command) {
+	command.Flags().StringP(mobilityModelValue, "", randomWaypointModel, "mobility model of vehicles [ "+
+		strings.Join([]string{randomWaypointModel, highwayModel, manhattanModel}, " | ")+" ]")
+	command.Flags().UintP(itemCountValue, "", 30, "count of vehicles present at each step")
+	command.Flags().UintP(bucketCountValue, "", 10, "count of buckets")
+	command.Flags().UintP(bucketSizeValue, "", 50, "size of bucket (if "+
+		constantBucketSizeValue+" is not specified, the value will be randomly generated from range [1 - "+
+		bucketSizeValue+"])")
+	command.Flags().BoolP(constantBucketSizeValue, "", false,
+		"disables random bucket sizes and enables constant size for all buckets")
+	command.Flags().UintP(stepCountValue, "", 10, "count of time steps")
+	command.Flags().Float64P(minSpeedValue, "", 0.01,
+		"minimum distance traveled by vehicle in a single step, where the side of the area is equal to 1")
+	command.Flags().Float64P(maxSpeedValue, "", 0.05,
+		"maximum distance traveled by vehicle in a single step, where the side of the area is equal to 1")
+	command.Flags().UintP(laneCountValue, "", 4, "count of lanes of "+highwayModel+" model")
+	command.Flags().UintP(blockCountValue, "", 5, "count of blocks along each side of area of "+manhattanModel+" model")
+	command.Flags().Int64P(seedValue, "", 0,
+		"seed of the generator (if not specified, the seed is based on the current time); the seed is stored"+
+			" along with other parameters of the generation in the scenario")
+	setUpRadioModelFlag(command)
+	setUpLayoutFlags(command)
+}
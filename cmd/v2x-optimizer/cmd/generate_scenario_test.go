@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generateScenario(t *testing.T) {
+	t.Parallel()
+
+	args := []string{"--model", highwayModel, "--items", "5", "--buckets", "4", "--steps", "3", "--seed", "1"}
+
+	t.Run("should generate scenario in json format", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "scenario.json")
+
+		command := GenerateScenarioCmd()
+		command.SetArgs(append([]string{jsonFormat, path}, args...))
+
+		err := command.Execute()
+		assert.NoError(t, err)
+
+		file, err := os.Open(path)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		s, err := encoder.ScenarioJSON{}.Decode(file)
+		assert.NoError(t, err)
+		assert.NoError(t, s.Validate())
+		assert.Len(t, s.Snapshots, 3)
+		assert.Len(t, s.Snapshots[0].MRB, 4)
+		assert.Len(t, s.Snapshots[0].R, 5)
+	})
+
+	t.Run("should generate the same scenario in directory of CPLEX files", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "scenario.json")
+
+		command := GenerateScenarioCmd()
+		command.SetArgs(append([]string{jsonFormat, path}, args...))
+		assert.NoError(t, command.Execute())
+
+		command = GenerateScenarioCmd()
+		command.SetArgs(append([]string{cplexFormat, filepath.Join(dir, "scenario")}, args...))
+		assert.NoError(t, command.Execute())
+
+		fromJSON, err := decodeJSONScenario(path)
+		assert.NoError(t, err)
+
+		fromDirectory, err := encoder.ScenarioDirectory{}.Decode(filepath.Join(dir, "scenario"))
+		assert.NoError(t, err)
+
+		for _, snapshot := range fromJSON.Snapshots {
+			assert.NotNil(t, snapshot.Metadata)
+		}
+
+		assert.Equal(t, fromJSON, fromDirectory)
+	})
+
+	t.Run("should store generation metadata in scenario", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "scenario.json")
+
+		command := GenerateScenarioCmd()
+		command.SetArgs(append([]string{jsonFormat, path}, args...))
+		assert.NoError(t, command.Execute())
+
+		command = GenerateScenarioCmd()
+		command.SetArgs(append([]string{cplexFormat, filepath.Join(dir, "scenario")}, args...))
+		assert.NoError(t, command.Execute())
+
+		fromJSON, err := decodeJSONScenario(path)
+		assert.NoError(t, err)
+
+		fromDirectory, err := encoder.ScenarioDirectory{}.Decode(filepath.Join(dir, "scenario"))
+		assert.NoError(t, err)
+
+		for _, s := range []*data.Scenario{fromJSON, fromDirectory} {
+			var metadata scenarioGenerationMetadata
+			assert.NoError(t, json.Unmarshal(s.Parameters, &metadata))
+			assert.Equal(t, int64(1), metadata.Seed)
+			assert.Equal(t, highwayModel, metadata.Model)
+			assert.Equal(t, 5, metadata.VehicleCount)
+			assert.Equal(t, 4, metadata.BucketCount)
+			assert.Equal(t, 3, metadata.Steps)
+			assert.Equal(t, 4, metadata.LaneCount)
+			assert.Nil(t, metadata.RadioModel)
+			assert.Nil(t, metadata.Layout)
+		}
+	})
+
+	t.Run("should return error if model is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		command := GenerateScenarioCmd()
+		command.SetArgs([]string{jsonFormat, filepath.Join(t.TempDir(), "scenario.json"), "--model", "unknown"})
+		command.SilenceUsage = true
+		command.SilenceErrors = true
+
+		assert.Error(t, command.Execute())
+	})
+}
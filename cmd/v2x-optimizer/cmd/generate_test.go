@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -11,7 +13,12 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	pathRunner "github.com/lothar1998/v2x-optimizer/internal/performance/runner/path"
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
 	encoderMock "github.com/lothar1998/v2x-optimizer/test/mocks/data"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +38,7 @@ func Test_generateWith(t *testing.T) {
 		{"should generate multiple files", args{3}},
 	}
 	for _, tt := range tests {
+		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
@@ -57,7 +65,7 @@ func Test_generateWith(t *testing.T) {
 					}).
 				Times(tt.args.count)
 
-			runnable := generateWith(encoder)
+			runnable := generateWith(encoder, false)
 
 			command := &cobra.Command{}
 			setUpGenerateFlags(command)
@@ -82,3 +90,203 @@ func Test_generateWith(t *testing.T) {
 		})
 	}
 }
+
+func Test_generateWith_seed(t *testing.T) {
+	t.Parallel()
+
+	generateWithSeed := func(t *testing.T, seed string) (string, string) {
+		dir, err := ioutil.TempDir("", "v2x-optimizer-generate-with-seed-*")
+		assert.NoError(t, err)
+
+		command := &cobra.Command{}
+		setUpGenerateFlags(command)
+		err = command.Flags().Set(seedValue, seed)
+		assert.NoError(t, err)
+		err = command.Flags().Set(kindValue, v2xKind)
+		assert.NoError(t, err)
+
+		err = generateWith(encoder.CPLEX{}, false)(command, []string{dir})
+		assert.NoError(t, err)
+
+		dataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(outputFilePattern, 0)))
+		assert.NoError(t, err)
+
+		metadataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(metadataFilePattern, 0)))
+		assert.NoError(t, err)
+
+		return string(dataContent), string(metadataContent)
+	}
+
+	t.Run("should generate the same data for the same seed", func(t *testing.T) {
+		t.Parallel()
+
+		firstData, _ := generateWithSeed(t, "123")
+		secondData, _ := generateWithSeed(t, "123")
+
+		assert.Equal(t, firstData, secondData)
+	})
+
+	t.Run("should record seed in metadata file", func(t *testing.T) {
+		t.Parallel()
+
+		_, metadataContent := generateWithSeed(t, "123")
+
+		var metadata generationMetadata
+		err := json.Unmarshal([]byte(metadataContent), &metadata)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(123), metadata.Seed)
+		assert.Equal(t, v2xKind, metadata.Kind)
+	})
+}
+
+func Test_generateWith_performanceRunner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate directory that can be evaluated by performance runner", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		command := &cobra.Command{}
+		setUpGenerateFlags(command)
+		assert.NoError(t, command.Flags().Set(kindValue, v2xKind))
+		assert.NoError(t, command.Flags().Set(countValue, "2"))
+		assert.NoError(t, command.Flags().Set(seedValue, "3"))
+
+		err := generateWith(encoder.CPLEX{}, false)(command, []string{dir})
+		assert.NoError(t, err)
+
+		optimizers := []optimizer.PerformanceSubjectOptimizer{
+			optimizer.NewPerformanceSubjectAdapter(firstfit.FirstFit{}, true),
+		}
+		runner := pathRunner.NewRunnerWithReference(executor.NewLowerBoundReference(), optimizers)
+
+		result := <-runner.Run(context.Background(), dir)
+
+		assert.NoError(t, result.Err)
+		assert.Len(t, result.FilesToResults, 2)
+		assert.Contains(t, result.FilesToResults, fmt.Sprintf(outputFilePattern, 0))
+		assert.Contains(t, result.FilesToResults, fmt.Sprintf(outputFilePattern, 1))
+	})
+}
+
+func Test_generateWith_radioModel(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	radioModelPath := filepath.Join(dir, "radio.json")
+	err := ioutil.WriteFile(radioModelPath, []byte(`{"min_demand": 10, "max_demand": 10}`), 0600)
+	assert.NoError(t, err)
+
+	command := &cobra.Command{}
+	setUpGenerateFlags(command)
+	assert.NoError(t, command.Flags().Set(kindValue, v2xKind))
+	assert.NoError(t, command.Flags().Set(radioModelValue, radioModelPath))
+
+	err = generateWith(encoder.JSON{}, true)(command, []string{dir})
+	assert.NoError(t, err)
+
+	dataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(outputFilePattern, 0)))
+	assert.NoError(t, err)
+
+	var generatedData data.Data
+	assert.NoError(t, json.Unmarshal(dataContent, &generatedData))
+	assert.NotEmpty(t, generatedData.R)
+
+	for _, sizes := range generatedData.R {
+		for _, size := range sizes {
+			assert.Equal(t, 1, size)
+		}
+	}
+
+	var metadata generationMetadata
+	assert.NoError(t, json.Unmarshal(generatedData.Metadata.Parameters, &metadata))
+	assert.NotNil(t, metadata.RadioModel)
+	assert.Equal(t, 10.0, metadata.RadioModel.MinDemand)
+}
+
+func Test_generateWith_layout(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	stationsPath := filepath.Join(dir, "stations.csv")
+	err := ioutil.WriteFile(stationsPath, []byte("0.1,0.1,7\n0.9,0.9,8\n"), 0600)
+	assert.NoError(t, err)
+
+	command := &cobra.Command{}
+	setUpGenerateFlags(command)
+	assert.NoError(t, command.Flags().Set(kindValue, v2xKind))
+	assert.NoError(t, command.Flags().Set(layoutValue, fileLayout))
+	assert.NoError(t, command.Flags().Set(stationsFileValue, stationsPath))
+
+	err = generateWith(encoder.JSON{}, true)(command, []string{dir})
+	assert.NoError(t, err)
+
+	dataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(outputFilePattern, 0)))
+	assert.NoError(t, err)
+
+	var generatedData data.Data
+	assert.NoError(t, json.Unmarshal(dataContent, &generatedData))
+	assert.Equal(t, []int{7, 8}, generatedData.MRB)
+
+	var metadata generationMetadata
+	assert.NoError(t, json.Unmarshal(generatedData.Metadata.Parameters, &metadata))
+	assert.Equal(t, &layoutConfig{Kind: fileLayout, StationsFile: stationsPath}, metadata.Layout)
+}
+
+func Test_generateWith_metadata(t *testing.T) {
+	t.Parallel()
+
+	generate := func(t *testing.T, dir string, encoder data.EncoderDecoder, storesMetadata bool) {
+		command := &cobra.Command{}
+		setUpGenerateFlags(command)
+		assert.NoError(t, command.Flags().Set(kindValue, v2xKind))
+		assert.NoError(t, command.Flags().Set(itemCountValue, "6"))
+		assert.NoError(t, command.Flags().Set(bucketCountValue, "3"))
+		assert.NoError(t, command.Flags().Set(seedValue, "42"))
+
+		err := generateWith(encoder, storesMetadata)(command, []string{dir})
+		assert.NoError(t, err)
+	}
+
+	t.Run("should store geometry and parameters in data if format preserves metadata", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		generate(t, dir, encoder.JSON{}, true)
+
+		dataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(outputFilePattern, 0)))
+		assert.NoError(t, err)
+
+		var generatedData data.Data
+		assert.NoError(t, json.Unmarshal(dataContent, &generatedData))
+		assert.NotNil(t, generatedData.Metadata)
+		assert.Len(t, generatedData.Metadata.Geometry.Vehicles, 6)
+		assert.Len(t, generatedData.Metadata.Geometry.Stations, 3)
+
+		var parameters generationMetadata
+		assert.NoError(t, json.Unmarshal(generatedData.Metadata.Parameters, &parameters))
+		assert.Equal(t, int64(42), parameters.Seed)
+		assert.Nil(t, parameters.Geometry)
+
+		assert.NoFileExists(t, filepath.Join(dir, fmt.Sprintf(metadataFilePattern, 0)))
+	})
+
+	t.Run("should store geometry and parameters in hidden file if format doesn't preserve metadata",
+		func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			generate(t, dir, encoder.CPLEX{}, false)
+
+			metadataContent, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(metadataFilePattern, 0)))
+			assert.NoError(t, err)
+
+			var metadata generationMetadata
+			assert.NoError(t, json.Unmarshal(metadataContent, &metadata))
+			assert.Equal(t, int64(42), metadata.Seed)
+			assert.NotNil(t, metadata.Geometry)
+			assert.Len(t, metadata.Geometry.Vehicles, 6)
+			assert.Len(t, metadata.Geometry.Stations, 3)
+		})
+}
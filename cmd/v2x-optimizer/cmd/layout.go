@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+)
+
+const (
+	layoutValue       = "layout"
+	roadValue         = "road"
+	stationsFileValue = "stations-file"
+
+	squareGridLayout = "grid"
+	hexagonalLayout  = "hex"
+	poissonLayout    = "ppp"
+	roadsideLayout   = "roadside"
+	fileLayout       = "file"
+)
+
+var layoutKinds = map[string]generator.LayoutKind{
+	squareGridLayout: generator.SquareGrid,
+	hexagonalLayout:  generator.HexagonalGrid,
+	poissonLayout:    generator.PoissonPointProcess,
+	roadsideLayout:   generator.Roadside,
+	fileLayout:       generator.Explicit,
+}
+
+// layoutConfig is the representation of generator.StationLayout given by the user.
+type layoutConfig struct {
+	Kind         string `json:"kind"`
+	Road         string `json:"road,omitempty"`
+	StationsFile string `json:"stations_file,omitempty"`
+}
+
+// getStationLayout returns the station layout given by the user and its representation. If the user has not
+// specified any layout other than the default one, the default layout and nil representation are returned.
+func getStationLayout(command *cobra.Command) (generator.StationLayout, *layoutConfig, error) {
+	var config layoutConfig
+	var err error
+
+	config.Kind, err = command.Flags().GetString(layoutValue)
+	if err != nil {
+		return generator.StationLayout{}, nil, err
+	}
+
+	kind, ok := layoutKinds[config.Kind]
+	if !ok {
+		return generator.StationLayout{}, nil, fmt.Errorf("unknown layout: %s", config.Kind)
+	}
+
+	if kind == generator.SquareGrid {
+		return generator.StationLayout{}, nil, nil
+	}
+
+	layout := generator.StationLayout{Kind: kind}
+
+	switch kind {
+	case generator.Roadside:
+		config.Road, err = command.Flags().GetString(roadValue)
+		if err != nil {
+			return generator.StationLayout{}, nil, err
+		}
+
+		layout.Road, err = parseRoad(config.Road)
+		if err != nil {
+			return generator.StationLayout{}, nil, err
+		}
+
+	case generator.Explicit:
+		config.StationsFile, err = command.Flags().GetString(stationsFileValue)
+		if err != nil {
+			return generator.StationLayout{}, nil, err
+		}
+
+		if config.StationsFile == "" {
+			return generator.StationLayout{}, nil, fmt.Errorf("%s is required by %s layout", stationsFileValue, fileLayout)
+		}
+
+		layout.Stations, err = readStations(config.StationsFile)
+		if err != nil {
+			return generator.StationLayout{}, nil, err
+		}
+	}
+
+	return layout, &config, layout.Validate()
+}
+
+// parseRoad parses points of road separated by spaces or semicolons, each of them in form of "x,y".
+func parseRoad(road string) ([]generator.Point, error) {
+	fields := strings.FieldsFunc(road, func(r rune) bool {
+		return r == ' ' || r == ';'
+	})
+
+	points := make([]generator.Point, len(fields))
+	for i, field := range fields {
+		coordinates := strings.Split(field, ",")
+		if len(coordinates) != 2 {
+			return nil, fmt.Errorf("cannot parse point of road: %s", field)
+		}
+
+		x, err := strconv.ParseFloat(coordinates[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse point of road: %s", field)
+		}
+
+		y, err := strconv.ParseFloat(coordinates[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse point of road: %s", field)
+		}
+
+		points[i] = generator.Point{X: x, Y: y}
+	}
+
+	return points, nil
+}
+
+// readStations reads stations from the file in JSON format if it has .json extension and in CSV format otherwise.
+func readStations(path string) ([]generator.Station, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errCannotOpenFile, path)
+	}
+	defer file.Close()
+
+	var stations []generator.Station
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		stations, err = generator.ReadStationsJSON(file)
+	} else {
+		stations, err = generator.ReadStationsCSV(file)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse stations: %w", err)
+	}
+
+	return stations, nil
+}
+
+func setUpLayoutFlags(command *cobra.Command) {
+	command.Flags().StringP(layoutValue, "", squareGridLayout, "layout of stations of v2x generator [ "+
+		strings.Join([]string{squareGridLayout, hexagonalLayout, poissonLayout, roadsideLayout, fileLayout}, " | ")+
+		" ]; coordinates are relative to the area, whose side is equal to 1")
+	command.Flags().StringP(roadValue, "", "",
+		"road of "+roadsideLayout+" layout as points separated by spaces, e.g. \"0,0.2 0.5,0.5 1,0.5\""+
+			" (if not specified, the horizontal road crossing the middle of the area is used)")
+	command.Flags().StringP(stationsFileValue, "", "",
+		"path to file with stations of "+fileLayout+" layout, either JSON array of objects with x, y and mrb fields"+
+			" if the file has .json extension or CSV lines in form of \"x,y,mrb\"; the stations replace "+
+			bucketCountValue+" and "+bucketSizeValue+" parameters")
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getStationLayout(t *testing.T) {
+	t.Parallel()
+
+	getStationLayoutWith := func(t *testing.T, flags map[string]string) (*generator.StationLayout, *layoutConfig, error) {
+		command := &cobra.Command{}
+		setUpLayoutFlags(command)
+		for name, value := range flags {
+			assert.NoError(t, command.Flags().Set(name, value))
+		}
+
+		layout, config, err := getStationLayout(command)
+		return &layout, config, err
+	}
+
+	writeFile := func(t *testing.T, name, content string) string {
+		path := filepath.Join(t.TempDir(), name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	t.Run("should return default layout if layout is not specified", func(t *testing.T) {
+		t.Parallel()
+
+		layout, config, err := getStationLayoutWith(t, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &generator.StationLayout{}, layout)
+		assert.Nil(t, config)
+	})
+
+	t.Run("should return hexagonal layout", func(t *testing.T) {
+		t.Parallel()
+
+		layout, config, err := getStationLayoutWith(t, map[string]string{layoutValue: hexagonalLayout})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &generator.StationLayout{Kind: generator.HexagonalGrid}, layout)
+		assert.Equal(t, &layoutConfig{Kind: hexagonalLayout}, config)
+	})
+
+	t.Run("should return roadside layout with road", func(t *testing.T) {
+		t.Parallel()
+
+		layout, config, err := getStationLayoutWith(t, map[string]string{
+			layoutValue: roadsideLayout,
+			roadValue:   "0,0.2 0.5,0.5;1,0.5",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []generator.Point{{X: 0, Y: 0.2}, {X: 0.5, Y: 0.5}, {X: 1, Y: 0.5}}, layout.Road)
+		assert.Equal(t, "0,0.2 0.5,0.5;1,0.5", config.Road)
+	})
+
+	t.Run("should return stations from CSV file", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeFile(t, "stations.csv", "0.1,0.2,10\n0.3,0.4,20\n")
+
+		layout, config, err := getStationLayoutWith(t, map[string]string{layoutValue: fileLayout, stationsFileValue: path})
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.Explicit, layout.Kind)
+		assert.Equal(t, []generator.Station{
+			{Point: generator.Point{X: 0.1, Y: 0.2}, MRB: 10},
+			{Point: generator.Point{X: 0.3, Y: 0.4}, MRB: 20},
+		}, layout.Stations)
+		assert.Equal(t, path, config.StationsFile)
+	})
+
+	t.Run("should return stations from JSON file", func(t *testing.T) {
+		t.Parallel()
+
+		path := writeFile(t, "stations.json", `[{"x": 0.1, "y": 0.2, "mrb": 10}]`)
+
+		layout, _, err := getStationLayoutWith(t, map[string]string{layoutValue: fileLayout, stationsFileValue: path})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []generator.Station{{Point: generator.Point{X: 0.1, Y: 0.2}, MRB: 10}}, layout.Stations)
+	})
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+	}{
+		{"should return error if layout is unknown", map[string]string{layoutValue: "unknown"}},
+		{"should return error if road is malformed", map[string]string{layoutValue: roadsideLayout, roadValue: "0,0 1"}},
+		{"should return error if road is a single point", map[string]string{layoutValue: roadsideLayout, roadValue: "0,0"}},
+		{"should return error if stations file is missing", map[string]string{layoutValue: fileLayout}},
+		{"should return error if stations file does not exist", map[string]string{
+			layoutValue:       fileLayout,
+			stationsFileValue: "/not/existing/stations.csv",
+		}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := getStationLayoutWith(t, tt.flags)
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const timeoutValue = "timeout"
+
 // OptimizeCmd returns cobra.Command which is able to optimize problem with specific algorithm.
 // It should be registered in root command using AddCommand() method.
 func OptimizeCmd() *cobra.Command {
@@ -33,16 +36,17 @@ func OptimizeCmd() *cobra.Command {
 	return optimizeCmd
 }
 
-func optimizeWith(configurator configurator.Configurator) *cobra.Command {
-	optimizerName := configurator.TypeName()
+func optimizeWith(optimizerConfigurator configurator.Configurator) *cobra.Command {
+	optimizerName := optimizerConfigurator.TypeName()
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("%s {data_file}", optimizerName),
 		Args:  cobra.ExactArgs(1),
 		Short: fmt.Sprintf("Optimize using %s", optimizerName),
 		Long:  fmt.Sprintf("Allows optimizing using %s", optimizerName),
-		RunE:  optimizeUsing(configurator.Builder()),
+		RunE:  optimizeUsing(optimizerConfigurator.Builder()),
 	}
-	configurator.SetUpFlags(cmd)
+	optimizerConfigurator.SetUpFlags(cmd)
+	configurator.SetUpSeedFlag(cmd)
 	return cmd
 }
 
@@ -76,7 +80,19 @@ func optimizeUsing(build configurator.BuildFunc) func(*cobra.Command, []string)
 			return err
 		}
 
-		result, err := opt.Optimize(command.Context(), data)
+		timeout, err := command.Flags().GetDuration(timeoutValue)
+		if err != nil {
+			return err
+		}
+
+		ctx := command.Context()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		result, err := opt.Optimize(ctx, data)
 		if err != nil {
 			return err
 		}
@@ -88,6 +104,13 @@ func optimizeUsing(build configurator.BuildFunc) func(*cobra.Command, []string)
 }
 
 func setUpOptimizeFlags(command *cobra.Command) {
+	setUpFormatFlag(command)
+	command.Flags().DurationP(timeoutValue, "", 0,
+		"time limit of optimization, e.g. 30s; optimizers that improve the solution iteratively return the best"+
+			" solution found so far, others fail (0 - no limit)")
+}
+
+func setUpFormatFlag(command *cobra.Command) {
 	command.Flags().StringP("format", "f", plainFormat,
 		"defines input data file format [ "+strings.Join(availableFileFormats, " | ")+" ]")
 }
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer/configurator"
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/scenario"
+	"github.com/spf13/cobra"
+)
+
+var formatsToScenarioDecoders = map[string]func(path string) (*data.Scenario, error){
+	jsonFormat:  decodeJSONScenario,
+	cplexFormat: encoder.ScenarioDirectory{}.Decode,
+}
+
+// OptimizeScenarioCmd returns cobra.Command which is able to optimize consecutive snapshots of a scenario
+// with specific algorithm. It should be registered in root command using AddCommand() method.
+func OptimizeScenarioCmd() *cobra.Command {
+	optimizeScenarioCmd := &cobra.Command{
+		Use:   "optimize-scenario",
+		Short: "Optimize scenario using given algorithm",
+		Long: "Allows optimizing each snapshot of scenario using given algorithm" +
+			" and reports changes of the assignment between consecutive snapshots." +
+			" The last line sums up the changes and averages the RRH count over all snapshots",
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+		},
+	}
+
+	for _, configurator := range config.RegisteredOptimizerConfigurators {
+		command := optimizeScenarioWith(configurator)
+		setUpOptimizeScenarioFlags(command)
+		optimizeScenarioCmd.AddCommand(command)
+	}
+
+	return optimizeScenarioCmd
+}
+
+func optimizeScenarioWith(optimizerConfigurator configurator.Configurator) *cobra.Command {
+	optimizerName := optimizerConfigurator.TypeName()
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s {scenario_path}", optimizerName),
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("Optimize scenario using %s", optimizerName),
+		Long: fmt.Sprintf("Allows optimizing scenario using %s. The scenario_path is a file in %s format"+
+			" or a directory of snapshot files in %s format", optimizerName, jsonFormat, cplexFormat),
+		RunE: optimizeScenarioUsing(optimizerConfigurator.Builder()),
+	}
+	optimizerConfigurator.SetUpFlags(cmd)
+	configurator.SetUpSeedFlag(cmd)
+	return cmd
+}
+
+func optimizeScenarioUsing(build configurator.BuildFunc) func(*cobra.Command, []string) error {
+	return func(command *cobra.Command, args []string) error {
+		input := args[0]
+
+		format, err := command.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+
+		decode, ok := formatsToScenarioDecoders[format]
+		if !ok {
+			return fmt.Errorf("%w: %s", errUnknownDataFormat, format)
+		}
+
+		decodedScenario, err := decode(input)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errCannotParseData, err.Error())
+		}
+
+		opt, err := build(command)
+		if err != nil {
+			return err
+		}
+
+		timeout, err := command.Flags().GetDuration(timeoutValue)
+		if err != nil {
+			return err
+		}
+
+		steps, err := scenario.Optimize(command.Context(), opt, decodedScenario, timeout)
+		if err != nil {
+			return err
+		}
+
+		outputSteps(command.OutOrStdout(), steps)
+
+		return nil
+	}
+}
+
+func decodeJSONScenario(path string) (*data.Scenario, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return encoder.ScenarioJSON{}.Decode(file)
+}
+
+func outputSteps(output io.Writer, steps []scenario.Step) {
+	w := tabwriter.NewWriter(output, 1, 1, 3, ' ', 0)
+
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		"Step", "Vehicles", "Entered", "Left", "RRH count", "Switched on", "Switched off", "Reassigned")
+
+	var total scenario.Step
+	var rrhCountSum int
+
+	for i, step := range steps {
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
+			i, step.Vehicles, step.Entered, step.Left, step.Result.RRHCount,
+			step.SwitchedOn, step.SwitchedOff, step.Reassigned)
+
+		total.Entered += step.Entered
+		total.Left += step.Left
+		total.SwitchedOn += step.SwitchedOn
+		total.SwitchedOff += step.SwitchedOff
+		total.Reassigned += step.Reassigned
+		rrhCountSum += step.Result.RRHCount
+	}
+
+	var averageRRHCount float64
+	if len(steps) > 0 {
+		averageRRHCount = float64(rrhCountSum) / float64(len(steps))
+	}
+
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.3f\t%d\t%d\t%d\n",
+		"Total", "-", total.Entered, total.Left, averageRRHCount,
+		total.SwitchedOn, total.SwitchedOff, total.Reassigned)
+
+	_ = w.Flush()
+}
+
+func setUpOptimizeScenarioFlags(command *cobra.Command) {
+	command.Flags().StringP("format", "f", jsonFormat,
+		"defines scenario format [ "+strings.Join([]string{jsonFormat, cplexFormat}, " | ")+" ]")
+	command.Flags().DurationP(timeoutValue, "", 0,
+		"time limit of optimization of each snapshot, e.g. 30s; optimizers that improve the solution iteratively"+
+			" return the best solution found so far, others fail (0 - no limit)")
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_optimizeScenario(t *testing.T) {
+	t.Parallel()
+
+	s := &data.Scenario{Snapshots: []data.Snapshot{
+		{Data: data.Data{MRB: []int{5, 5}, R: [][]int{{3, 3}, {2, 2}}}, VehicleIDs: []int{1, 2}},
+		{Data: data.Data{MRB: []int{5, 5}, R: [][]int{{4, 4}, {3, 3}}}, VehicleIDs: []int{1, 3}},
+	}}
+
+	expectedLines := [][]string{
+		{"Step", "Vehicles", "Entered", "Left", "RRH", "count", "Switched", "on", "Switched", "off", "Reassigned"},
+		{"0", "2", "2", "0", "1", "1", "0", "0"},
+		{"1", "2", "1", "1", "2", "1", "0", "0"},
+		{"Total", "-", "3", "1", "1.500", "2", "0", "0"},
+	}
+
+	t.Run("should optimize scenario in json format", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "scenario.json")
+		file, err := os.Create(path)
+		assert.NoError(t, err)
+		assert.NoError(t, encoder.ScenarioJSON{}.Encode(s, file))
+		assert.NoError(t, file.Close())
+
+		var output bytes.Buffer
+
+		command := OptimizeScenarioCmd()
+		command.SetOut(&output)
+		command.SetArgs([]string{"FirstFit", path})
+
+		err = command.Execute()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLines, toFields(output.String()))
+	})
+
+	t.Run("should optimize scenario in directory of CPLEX files", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		assert.NoError(t, encoder.ScenarioDirectory{}.Encode(s, dir))
+
+		var output bytes.Buffer
+
+		command := OptimizeScenarioCmd()
+		command.SetOut(&output)
+		command.SetArgs([]string{"FirstFit", "--format", cplexFormat, dir})
+
+		err := command.Execute()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLines, toFields(output.String()))
+	})
+}
+
+func toFields(output string) [][]string {
+	var fields [][]string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields = append(fields, strings.Fields(line))
+	}
+	return fields
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+)
+
+const (
+	radioModelValue = "radio-model"
+
+	logDistancePathLoss = "log-distance"
+	freeSpacePathLoss   = "free-space"
+	umiLOSPathLoss      = "umi-los"
+	umiNLOSPathLoss     = "umi-nlos"
+	umaLOSPathLoss      = "uma-los"
+	umaNLOSPathLoss     = "uma-nlos"
+)
+
+var pathLossModels = map[string]func(config pathLossConfig) generator.PathLossModel{
+	logDistancePathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.LogDistance(config.Exponent, config.ReferenceDistance, config.ReferenceLoss)
+	},
+	freeSpacePathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.FreeSpace(config.Frequency)
+	},
+	umiLOSPathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.UMiLOS(config.Frequency)
+	},
+	umiNLOSPathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.UMiNLOS(config.Frequency)
+	},
+	umaLOSPathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.UMaLOS(config.Frequency)
+	},
+	umaNLOSPathLoss: func(config pathLossConfig) generator.PathLossModel {
+		return generator.UMaNLOS(config.Frequency)
+	},
+}
+
+// radioModelConfig is the JSON representation of generator.RadioModel. Fields that are not specified
+// in the config file keep values of generator.DefaultRadioModel. The MCS table is read from MCSTableFile
+// if it is specified, see generator.ReadMCSTable for its format. Bandwidth, NoiseFigure and ActivityFactor
+// are used only in SINR mode.
+type radioModelConfig struct {
+	TransmitPower   float64        `json:"transmit_power"`
+	AreaSideLength  float64        `json:"area_side_length"`
+	PathLoss        pathLossConfig `json:"path_loss"`
+	ShadowingStdDev float64        `json:"shadowing_std_dev"`
+	MinDemand       float64        `json:"min_demand"`
+	MaxDemand       float64        `json:"max_demand"`
+	MCSTableFile    string         `json:"mcs_table_file,omitempty"`
+	SINR            bool           `json:"sinr"`
+	Bandwidth       float64        `json:"bandwidth"`
+	NoiseFigure     float64        `json:"noise_figure"`
+	ActivityFactor  float64        `json:"activity_factor"`
+}
+
+// pathLossConfig defines path-loss model. Exponent, ReferenceDistance and ReferenceLoss are used only by
+// log-distance model, while Frequency in GHz is used only by the others.
+type pathLossConfig struct {
+	Model             string  `json:"model"`
+	Exponent          float64 `json:"exponent,omitempty"`
+	ReferenceDistance float64 `json:"reference_distance,omitempty"`
+	ReferenceLoss     float64 `json:"reference_loss,omitempty"`
+	Frequency         float64 `json:"frequency,omitempty"`
+}
+
+func defaultRadioModelConfig() radioModelConfig {
+	model := generator.DefaultRadioModel()
+
+	return radioModelConfig{
+		TransmitPower:  model.TransmitPower,
+		AreaSideLength: model.AreaSideLength,
+		PathLoss: pathLossConfig{
+			Model:             logDistancePathLoss,
+			Exponent:          3.5,
+			ReferenceDistance: 1,
+		},
+		MinDemand:      model.MinDemand,
+		MaxDemand:      model.MaxDemand,
+		Bandwidth:      model.Bandwidth,
+		NoiseFigure:    model.NoiseFigure,
+		ActivityFactor: model.ActivityFactor,
+	}
+}
+
+// getRadioModel returns the radio model defined by the config file given by the user and the config itself.
+// If the user has not specified it, both of them are nil.
+func getRadioModel(command *cobra.Command) (*generator.RadioModel, *radioModelConfig, error) {
+	configPath, err := command.Flags().GetString(radioModelValue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if configPath == "" {
+		return nil, nil, nil
+	}
+
+	config, err := readRadioModelConfig(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	model, err := config.toRadioModel()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return model, config, nil
+}
+
+func readRadioModelConfig(configPath string) (*radioModelConfig, error) {
+	file, err := os.Open(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errCannotOpenFile, configPath)
+	}
+	defer file.Close()
+
+	config := defaultRadioModelConfig()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse radio model config: %w", err)
+	}
+
+	return &config, nil
+}
+
+func (c *radioModelConfig) toRadioModel() (*generator.RadioModel, error) {
+	newPathLoss, ok := pathLossModels[c.PathLoss.Model]
+	if !ok {
+		return nil, fmt.Errorf("unknown path loss model: %s", c.PathLoss.Model)
+	}
+
+	if c.PathLoss.Model == logDistancePathLoss && c.PathLoss.ReferenceDistance <= 0 {
+		return nil, fmt.Errorf("%w: reference distance has to be positive", generator.ErrInvalidRadioModel)
+	}
+
+	if c.PathLoss.Model != logDistancePathLoss && c.PathLoss.Frequency <= 0 {
+		return nil, fmt.Errorf("%w: frequency has to be positive", generator.ErrInvalidRadioModel)
+	}
+
+	mcsTable := generator.DefaultMCSTable()
+
+	if c.MCSTableFile != "" {
+		file, err := os.Open(c.MCSTableFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errCannotOpenFile, c.MCSTableFile)
+		}
+		defer file.Close()
+
+		mcsTable, err = generator.ReadMCSTable(file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse MCS table: %w", err)
+		}
+	}
+
+	model := &generator.RadioModel{
+		TransmitPower:   c.TransmitPower,
+		AreaSideLength:  c.AreaSideLength,
+		PathLoss:        newPathLoss(c.PathLoss),
+		ShadowingStdDev: c.ShadowingStdDev,
+		MinDemand:       c.MinDemand,
+		MaxDemand:       c.MaxDemand,
+		MCSTable:        mcsTable,
+		SINR:            c.SINR,
+		Bandwidth:       c.Bandwidth,
+		NoiseFigure:     c.NoiseFigure,
+		ActivityFactor:  c.ActivityFactor,
+	}
+
+	return model, model.Validate()
+}
+
+func setUpRadioModelFlag(command *cobra.Command) {
+	command.Flags().StringP(radioModelValue, "", "",
+		"path to JSON file with radio model of v2x generator, e.g. {\"area_side_length\": 1000,"+
+			" \"path_loss\": {\"model\": \""+umiNLOSPathLoss+"\", \"frequency\": 5.9}, \"shadowing_std_dev\": 7.82,"+
+			" \"min_demand\": 512, \"max_demand\": 2048, \"mcs_table_file\": \"mcs.csv\"};"+
+			" available path loss models [ "+strings.Join([]string{logDistancePathLoss, freeSpacePathLoss,
+			umiLOSPathLoss, umiNLOSPathLoss, umaLOSPathLoss, umaNLOSPathLoss}, " | ")+" ];"+
+			" MCS table file consists of lines in form of \"min_sinr_db,data_rate\";"+
+			" with \"sinr\": true, transmit power is expressed in mW and the quality of the signal includes"+
+			" thermal noise defined by \"bandwidth\" in Hz and \"noise_figure\" in dB and interference"+
+			" of other stations weighted by \"activity_factor\""+
+			" (if not specified, the default model is used)")
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getRadioModel(t *testing.T) {
+	t.Parallel()
+
+	// getRadioModelFrom writes the files to a temporary directory, which replaces {dir} in their content,
+	// and returns the radio model defined by radio.json file if it is given.
+	getRadioModelFrom := func(t *testing.T, files map[string]string) (*generator.RadioModel, *radioModelConfig, error) {
+		dir := t.TempDir()
+		for name, content := range files {
+			content = strings.ReplaceAll(content, "{dir}", dir)
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+		}
+
+		command := &cobra.Command{}
+		setUpRadioModelFlag(command)
+		if _, ok := files["radio.json"]; ok {
+			assert.NoError(t, command.Flags().Set(radioModelValue, filepath.Join(dir, "radio.json")))
+		}
+
+		return getRadioModel(command)
+	}
+
+	t.Run("should return nil if radio model is not specified", func(t *testing.T) {
+		t.Parallel()
+
+		model, config, err := getRadioModelFrom(t, nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, model)
+		assert.Nil(t, config)
+	})
+
+	t.Run("should keep default values of fields that are not specified", func(t *testing.T) {
+		t.Parallel()
+
+		model, config, err := getRadioModelFrom(t, map[string]string{"radio.json": `{"shadowing_std_dev": 4}`})
+
+		assert.NoError(t, err)
+
+		expectedConfig := defaultRadioModelConfig()
+		expectedConfig.ShadowingStdDev = 4
+		assert.Equal(t, &expectedConfig, config)
+
+		defaultModel := generator.DefaultRadioModel()
+		assert.Equal(t, defaultModel.TransmitPower, model.TransmitPower)
+		assert.Equal(t, defaultModel.MCSTable, model.MCSTable)
+		assert.Equal(t, 4.0, model.ShadowingStdDev)
+		assert.Equal(t, defaultModel.PathLoss(0.5), model.PathLoss(0.5))
+	})
+
+	t.Run("should build path loss model", func(t *testing.T) {
+		t.Parallel()
+
+		model, _, err := getRadioModelFrom(t, map[string]string{
+			"radio.json": `{"area_side_length": 1000, "path_loss": {"model": "umi-nlos", "frequency": 5.9}}`,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.UMiNLOS(5.9)(100), model.PathLoss(100))
+	})
+
+	t.Run("should build radio model in SINR mode", func(t *testing.T) {
+		t.Parallel()
+
+		model, _, err := getRadioModelFrom(t, map[string]string{
+			"radio.json": `{"transmit_power": 200, "sinr": true, "bandwidth": 1e7, "activity_factor": 0.3}`,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, model.SINR)
+		assert.Equal(t, 200.0, model.TransmitPower)
+		assert.Equal(t, 1e7, model.Bandwidth)
+		assert.Equal(t, generator.DefaultRadioModel().NoiseFigure, model.NoiseFigure)
+		assert.Equal(t, 0.3, model.ActivityFactor)
+	})
+
+	t.Run("should read MCS table file", func(t *testing.T) {
+		t.Parallel()
+
+		model, _, err := getRadioModelFrom(t, map[string]string{
+			"radio.json": `{"mcs_table_file": "{dir}/mcs.csv"}`,
+			"mcs.csv":    "0,100\n10,200\n",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.MCSTable{{MinSINR: 1, DataRate: 100}, {MinSINR: 10, DataRate: 200}}, model.MCSTable)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"should return error if path loss model is unknown", `{"path_loss": {"model": "unknown"}}`},
+		{"should return error if frequency is missing", `{"path_loss": {"model": "free-space"}}`},
+		{"should return error if field is unknown", `{"power": 2}`},
+		{"should return error if radio model is invalid", `{"min_demand": 10, "max_demand": 5}`},
+		{"should return error if activity factor is invalid", `{"sinr": true, "activity_factor": 2}`},
+		{"should return error if MCS table file does not exist", `{"mcs_table_file": "/not/existing/mcs.csv"}`},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := getRadioModelFrom(t, map[string]string{"radio.json": tt.content})
+
+			assert.Error(t, err)
+		})
+	}
+}
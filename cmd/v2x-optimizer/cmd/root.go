@@ -17,6 +17,9 @@ func Execute() {
 		GenerateCmd(),
 		ConvertCmd(),
 		OptimizeCmd(),
+		GenerateScenarioCmd(),
+		OptimizeScenarioCmd(),
+		VerifyCmd(),
 	)
 	cobra.CheckErr(rootCmd.Execute())
 }
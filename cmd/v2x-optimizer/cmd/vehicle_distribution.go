@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+)
+
+const (
+	vehicleDistributionValue = "vehicles"
+	clusterCountValue        = "clusters"
+	clusterSpreadValue       = "cluster-spread"
+	roadsValue               = "roads"
+	roadWidthValue           = "road-width"
+	jamSegmentValue          = "jam-segment"
+	jamShareValue            = "jam-share"
+
+	uniformVehicles     = "uniform"
+	thomasVehicles      = "thomas"
+	maternVehicles      = "matern"
+	roadNetworkVehicles = "roads"
+	trafficJamVehicles  = "jam"
+
+	roadSeparator = "|"
+)
+
+var vehicleDistributionKinds = map[string]generator.VehicleDistributionKind{
+	uniformVehicles:     generator.UniformVehicles,
+	thomasVehicles:      generator.ThomasCluster,
+	maternVehicles:      generator.MaternCluster,
+	roadNetworkVehicles: generator.RoadNetwork,
+	trafficJamVehicles:  generator.TrafficJam,
+}
+
+// vehicleDistributionConfig is the representation of generator.VehicleDistribution given by the user.
+// Parameters are stored only if they are used by the kind of the distribution.
+type vehicleDistributionConfig struct {
+	Kind          string  `json:"kind"`
+	ClusterCount  uint    `json:"clusters,omitempty"`
+	ClusterSpread float64 `json:"cluster_spread,omitempty"`
+	Roads         string  `json:"roads,omitempty"`
+	RoadWidth     float64 `json:"road_width,omitempty"`
+	JamSegment    string  `json:"jam_segment,omitempty"`
+	JamShare      float64 `json:"jam_share,omitempty"`
+}
+
+// getVehicleDistribution returns the vehicle distribution given by the user and its representation.
+// If the user has not specified any distribution other than the uniform one, the uniform distribution
+// and nil representation are returned.
+func getVehicleDistribution(command *cobra.Command) (generator.VehicleDistribution, *vehicleDistributionConfig, error) {
+	var config vehicleDistributionConfig
+	var err error
+
+	config.Kind, err = command.Flags().GetString(vehicleDistributionValue)
+	if err != nil {
+		return generator.VehicleDistribution{}, nil, err
+	}
+
+	kind, ok := vehicleDistributionKinds[config.Kind]
+	if !ok {
+		return generator.VehicleDistribution{}, nil, fmt.Errorf("unknown vehicle distribution: %s", config.Kind)
+	}
+
+	if kind == generator.UniformVehicles {
+		return generator.VehicleDistribution{}, nil, nil
+	}
+
+	distribution := generator.VehicleDistribution{Kind: kind}
+
+	switch kind {
+	case generator.ThomasCluster, generator.MaternCluster:
+		config.ClusterCount, err = command.Flags().GetUint(clusterCountValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		config.ClusterSpread, err = command.Flags().GetFloat64(clusterSpreadValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		distribution.ClusterCount = int(config.ClusterCount)
+		distribution.ClusterSpread = config.ClusterSpread
+
+	case generator.RoadNetwork:
+		config.Roads, err = command.Flags().GetString(roadsValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		for _, road := range strings.Split(config.Roads, roadSeparator) {
+			points, err := parseRoad(road)
+			if err != nil {
+				return generator.VehicleDistribution{}, nil, err
+			}
+
+			if len(points) > 0 {
+				distribution.Roads = append(distribution.Roads, points)
+			}
+		}
+
+	case generator.TrafficJam:
+		config.JamSegment, err = command.Flags().GetString(jamSegmentValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		segment, err := parseRoad(config.JamSegment)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		if len(segment) != 2 {
+			return generator.VehicleDistribution{}, nil, errors.New("jam segment has to consist of two points")
+		}
+
+		config.JamShare, err = command.Flags().GetFloat64(jamShareValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		distribution.JamSegment = [2]generator.Point{segment[0], segment[1]}
+		distribution.JamShare = config.JamShare
+	}
+
+	if kind == generator.RoadNetwork || kind == generator.TrafficJam {
+		config.RoadWidth, err = command.Flags().GetFloat64(roadWidthValue)
+		if err != nil {
+			return generator.VehicleDistribution{}, nil, err
+		}
+
+		distribution.RoadWidth = config.RoadWidth
+	}
+
+	return distribution, &config, distribution.Validate()
+}
+
+func setUpVehicleDistributionFlags(command *cobra.Command) {
+	command.Flags().StringP(vehicleDistributionValue, "", uniformVehicles,
+		"distribution of vehicles of v2x generator [ "+strings.Join([]string{uniformVehicles, thomasVehicles,
+			maternVehicles, roadNetworkVehicles, trafficJamVehicles}, " | ")+
+			" ]; coordinates and distances are relative to the area, whose side is equal to 1")
+	command.Flags().UintP(clusterCountValue, "", 5,
+		"count of hotspots of "+thomasVehicles+" and "+maternVehicles+" distributions")
+	command.Flags().Float64P(clusterSpreadValue, "", 0.05,
+		"standard deviation of distance from hotspot of "+thomasVehicles+" distribution"+
+			" or radius of hotspot of "+maternVehicles+" distribution")
+	command.Flags().StringP(roadsValue, "", "",
+		"roads of "+roadNetworkVehicles+" distribution separated by \""+roadSeparator+"\", each of them as points"+
+			" separated by spaces, e.g. \"0,0.5 1,0.5 | 0.5,0 0.5,1\""+
+			" (if not specified, the horizontal road crossing the middle of the area is used)")
+	command.Flags().Float64P(roadWidthValue, "", 0.02,
+		"width of lanes of "+roadNetworkVehicles+" and "+trafficJamVehicles+" distributions")
+	command.Flags().StringP(jamSegmentValue, "", "0.2,0.5 0.5,0.5",
+		"segment of road with queue of vehicles of "+trafficJamVehicles+" distribution as two points separated by space")
+	command.Flags().Float64P(jamShareValue, "", 0.5,
+		"share of vehicles queued on jam segment of "+trafficJamVehicles+" distribution,"+
+			" the rest of them are placed uniformly")
+}
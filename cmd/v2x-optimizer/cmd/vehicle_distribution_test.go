@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data/generator"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getVehicleDistribution(t *testing.T) {
+	t.Parallel()
+
+	getVehicleDistributionWith := func(
+		t *testing.T,
+		flags map[string]string,
+	) (generator.VehicleDistribution, *vehicleDistributionConfig, error) {
+		command := &cobra.Command{}
+		setUpVehicleDistributionFlags(command)
+		for name, value := range flags {
+			assert.NoError(t, command.Flags().Set(name, value))
+		}
+
+		return getVehicleDistribution(command)
+	}
+
+	t.Run("should return uniform distribution if distribution is not specified", func(t *testing.T) {
+		t.Parallel()
+
+		distribution, config, err := getVehicleDistributionWith(t, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.VehicleDistribution{}, distribution)
+		assert.Nil(t, config)
+	})
+
+	t.Run("should return cluster distribution", func(t *testing.T) {
+		t.Parallel()
+
+		distribution, config, err := getVehicleDistributionWith(t, map[string]string{
+			vehicleDistributionValue: maternVehicles,
+			clusterCountValue:        "3",
+			clusterSpreadValue:       "0.1",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t,
+			generator.VehicleDistribution{Kind: generator.MaternCluster, ClusterCount: 3, ClusterSpread: 0.1},
+			distribution)
+		assert.Equal(t, &vehicleDistributionConfig{Kind: maternVehicles, ClusterCount: 3, ClusterSpread: 0.1}, config)
+	})
+
+	t.Run("should return road network distribution", func(t *testing.T) {
+		t.Parallel()
+
+		distribution, config, err := getVehicleDistributionWith(t, map[string]string{
+			vehicleDistributionValue: roadNetworkVehicles,
+			roadsValue:               "0,0.5 1,0.5 | 0.5,0 0.5,0.5 1,1",
+			roadWidthValue:           "0.01",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.VehicleDistribution{
+			Kind: generator.RoadNetwork,
+			Roads: [][]generator.Point{
+				{{X: 0, Y: 0.5}, {X: 1, Y: 0.5}},
+				{{X: 0.5, Y: 0}, {X: 0.5, Y: 0.5}, {X: 1, Y: 1}},
+			},
+			RoadWidth: 0.01,
+		}, distribution)
+		assert.Equal(t, 0.01, config.RoadWidth)
+	})
+
+	t.Run("should return traffic jam distribution with default parameters", func(t *testing.T) {
+		t.Parallel()
+
+		distribution, config, err := getVehicleDistributionWith(t, map[string]string{
+			vehicleDistributionValue: trafficJamVehicles,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, generator.VehicleDistribution{
+			Kind:       generator.TrafficJam,
+			JamSegment: [2]generator.Point{{X: 0.2, Y: 0.5}, {X: 0.5, Y: 0.5}},
+			JamShare:   0.5,
+			RoadWidth:  0.02,
+		}, distribution)
+		assert.Equal(t, "0.2,0.5 0.5,0.5", config.JamSegment)
+	})
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+	}{
+		{"should return error if distribution is unknown", map[string]string{vehicleDistributionValue: "unknown"}},
+		{"should return error if cluster count is 0", map[string]string{
+			vehicleDistributionValue: thomasVehicles,
+			clusterCountValue:        "0",
+		}},
+		{"should return error if road is malformed", map[string]string{
+			vehicleDistributionValue: roadNetworkVehicles,
+			roadsValue:               "0,0 1,1 | 0.5",
+		}},
+		{"should return error if jam segment is not a segment", map[string]string{
+			vehicleDistributionValue: trafficJamVehicles,
+			jamSegmentValue:          "0,0 1,1 1,0",
+		}},
+		{"should return error if jam share is out of range", map[string]string{
+			vehicleDistributionValue: trafficJamVehicles,
+			jamShareValue:            "1.5",
+		}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := getVehicleDistributionWith(t, tt.flags)
+
+			assert.Error(t, err)
+		})
+	}
+}
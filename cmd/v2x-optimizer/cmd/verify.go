@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/lothar1998/v2x-optimizer/internal/console"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/spf13/cobra"
+)
+
+// VerifyCmd returns cobra.Command which is able to verify if the result is a feasible solution for the given data.
+// It should be registered in root command using AddCommand() method.
+func VerifyCmd() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify {data_file} {result_file}",
+		Args:  cobra.ExactArgs(2),
+		Short: "Verify feasibility of the result",
+		Long: "Allows for verifying if the result printed by the optimize command " +
+			"is a feasible solution for the given data",
+		RunE: verify,
+	}
+
+	setUpFormatFlag(verifyCmd)
+
+	return verifyCmd
+}
+
+func verify(command *cobra.Command, args []string) error {
+	dataInput, resultInput := args[0], args[1]
+
+	format, err := command.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	encoderInfo, ok := formatsToEncodersInfo[format]
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownDataFormat, format)
+	}
+
+	dataFile, err := os.Open(dataInput)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errCannotOpenFile, dataInput)
+	}
+	defer dataFile.Close()
+
+	data, err := encoderInfo.Encoder.Decode(dataFile)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errCannotParseData, err.Error())
+	}
+
+	resultContent, err := ioutil.ReadFile(resultInput)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errCannotOpenFile, resultInput)
+	}
+
+	result, err := console.FromOutput(string(resultContent))
+	if err != nil {
+		return fmt.Errorf("%w: %s", errCannotParseResult, err.Error())
+	}
+
+	if err := optimizer.Validate(data, result); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(command.OutOrStdout(), "result is valid")
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/internal/console"
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_verify(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{5, 5},
+		R: [][]int{
+			{3, 3},
+			{2, 4},
+		},
+	}
+
+	t.Run("should accept feasible result", func(t *testing.T) {
+		t.Parallel()
+
+		dataFile := writeTempDataFile(t, d)
+		resultFile := writeTempResultFile(t, &optimizer.Result{
+			RRHCount:                1,
+			RRHEnable:               []bool{true, false},
+			VehiclesToRRHAssignment: []int{0, 0},
+		})
+
+		var output bytes.Buffer
+
+		command := VerifyCmd()
+		command.SetOut(&output)
+		command.SetArgs([]string{dataFile, resultFile})
+
+		err := command.Execute()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "result is valid\n", output.String())
+	})
+
+	t.Run("should reject infeasible result", func(t *testing.T) {
+		t.Parallel()
+
+		dataFile := writeTempDataFile(t, d)
+		resultFile := writeTempResultFile(t, &optimizer.Result{
+			RRHCount:                1,
+			RRHEnable:               []bool{false, true},
+			VehiclesToRRHAssignment: []int{1, 1},
+		})
+
+		command := VerifyCmd()
+		command.SetOut(ioutil.Discard)
+		command.SetErr(ioutil.Discard)
+		command.SetArgs([]string{dataFile, resultFile})
+
+		err := command.Execute()
+
+		assert.ErrorIs(t, err, optimizer.ErrInvalidResult)
+	})
+
+	t.Run("should handle missing result file", func(t *testing.T) {
+		t.Parallel()
+
+		dataFile := writeTempDataFile(t, d)
+
+		command := VerifyCmd()
+		command.SetOut(ioutil.Discard)
+		command.SetErr(ioutil.Discard)
+		command.SetArgs([]string{dataFile, dataFile + "-not-existing"})
+
+		err := command.Execute()
+
+		assert.ErrorIs(t, err, errCannotOpenFile)
+	})
+}
+
+func writeTempDataFile(t *testing.T, d *data.Data) string {
+	file, err := ioutil.TempFile("", "v2x-optimizer-verify-data-*")
+	assert.NoError(t, err)
+	defer file.Close()
+
+	assert.NoError(t, encoder.Plain{}.Encode(d, file))
+	t.Cleanup(func() { _ = os.Remove(file.Name()) })
+
+	return file.Name()
+}
+
+func writeTempResultFile(t *testing.T, result *optimizer.Result) string {
+	file, err := ioutil.TempFile("", "v2x-optimizer-verify-result-*")
+	assert.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.WriteString(console.ToOutput(result))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = os.Remove(file.Name()) })
+
+	return file.Name()
+}
+
+func TestVerifyCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should not accept timeout flag", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotNil(t, VerifyCmd().Flags().Lookup("format"))
+		assert.Nil(t, VerifyCmd().Flags().Lookup(timeoutValue))
+	})
+}
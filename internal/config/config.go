@@ -3,6 +3,7 @@ package config
 import (
 	optimizerConfigurator "github.com/lothar1998/v2x-optimizer/internal/performance/optimizer/configurator"
 	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/almostworstfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/branchandbound"
 	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
 	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/nextfit"
 	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/worstfit"
@@ -12,6 +13,15 @@ import (
 // because CPLEX doesn't have optimizer.Optimizer implementation.
 const CPLEXOptimizerName = "CPLEX"
 
+// KnownValuesReferenceName is a name of reference that provides the best known values read from a file.
+const KnownValuesReferenceName = "BestKnown"
+
+// LowerBoundReferenceName is a name of reference that provides lower bounds on the optimal values.
+const LowerBoundReferenceName = "LowerBound"
+
+// ExternalSolverReferenceName is a name of reference that runs an external MIP solver.
+const ExternalSolverReferenceName = "ExternalSolver"
+
 // RegisteredOptimizerConfigurators is a list of all possible configurators.
 var RegisteredOptimizerConfigurators = []optimizerConfigurator.Configurator{
 	optimizerConfigurator.NewParameterless(firstfit.FirstFit{}),
@@ -22,4 +32,9 @@ var RegisteredOptimizerConfigurators = []optimizerConfigurator.Configurator{
 	optimizerConfigurator.BestFitConfigurator{},
 	optimizerConfigurator.BucketPoolBestFitConfigurator{},
 	optimizerConfigurator.BucketOrientedFitConfigurator{},
+	optimizerConfigurator.NewParameterless(branchandbound.BranchAndBound{}),
+	optimizerConfigurator.SimulatedAnnealingConfigurator{},
+	optimizerConfigurator.TabuSearchConfigurator{},
+	optimizerConfigurator.GeneticConfigurator{},
+	optimizerConfigurator.RuinAndRecreateConfigurator{},
 }
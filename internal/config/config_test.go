@@ -0,0 +1,28 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/optimizertest"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisteredOptimizerConfigurators_Conformance(t *testing.T) {
+	t.Parallel()
+
+	for _, configurator := range RegisteredOptimizerConfigurators {
+		configurator := configurator
+		t.Run(configurator.TypeName()+" should conform to optimizer contract", func(t *testing.T) {
+			t.Parallel()
+
+			command := &cobra.Command{}
+			configurator.SetUpFlags(command)
+
+			opt, err := configurator.Builder()(command)
+			assert.NoError(t, err)
+
+			optimizertest.TestConformance(t, opt)
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 )
 
 const Filename = ".optimizer_cache"
@@ -27,13 +29,21 @@ type Cache interface {
 
 type Data map[string]*FileInfo
 
+// FileInfo holds cached values for a single file. Samples hold the values of all runs
+// of non-deterministic optimizers that have been run repeatedly.
 type FileInfo struct {
 	Hash    string              `json:"hash"`
 	Results OptimizersToResults `json:"results,omitempty"`
+	Timings OptimizersToTimings `json:"timings,omitempty"`
+	Samples OptimizersToSamples `json:"samples,omitempty"`
 }
 
 type OptimizersToResults map[string]int
 
+type OptimizersToTimings map[string]timing.Timing
+
+type OptimizersToSamples map[string][]int
+
 type LocalCache struct {
 	mu   sync.RWMutex
 	data Data
@@ -100,7 +110,7 @@ func (c *LocalCache) Verify(file string) (*FileInfo, error) {
 	}
 
 	if hash != c.Get(file).Hash {
-		return &FileInfo{Hash: hash, Results: make(OptimizersToResults)}, nil
+		return &FileInfo{Hash: hash, Results: make(OptimizersToResults), Timings: make(OptimizersToTimings)}, nil
 	}
 
 	return nil, nil
@@ -112,7 +122,7 @@ func (c *LocalCache) AddFile(file string) error {
 		return err
 	}
 
-	c.Put(file, &FileInfo{hash, make(OptimizersToResults)})
+	c.Put(file, &FileInfo{Hash: hash, Results: make(OptimizersToResults), Timings: make(OptimizersToTimings)})
 
 	return nil
 }
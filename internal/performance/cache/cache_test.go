@@ -210,20 +210,20 @@ func getLocalCache() *LocalCache {
 	return &LocalCache{
 		data: Data{
 			"data1.dat": &FileInfo{
-				"88ae80225f77e46c036310cc276a24a0",
-				map[string]int{"first-optimizer": 23, "second-optimizer": 32},
+				Hash:    "88ae80225f77e46c036310cc276a24a0",
+				Results: map[string]int{"first-optimizer": 23, "second-optimizer": 32},
 			},
 			"data2.dat": &FileInfo{
-				"915d2411328ecc2bb108bb349676757a",
-				map[string]int{"first-optimizer": 12},
+				Hash:    "915d2411328ecc2bb108bb349676757a",
+				Results: map[string]int{"first-optimizer": 12},
 			},
 			"data3.dat": &FileInfo{
-				"98de420d7605c0fc107900b22026290d",
-				nil,
+				Hash:    "98de420d7605c0fc107900b22026290d",
+				Results: nil,
 			},
 			"data4.dat": &FileInfo{
-				"c6374f0e34658a8bf3df7e210a58bb65",
-				map[string]int{"first-optimizer": 13, "second-optimizer": 1, "third-optimizer": 78},
+				Hash:    "c6374f0e34658a8bf3df7e210a58bb65",
+				Results: map[string]int{"first-optimizer": 13, "second-optimizer": 1, "third-optimizer": 78},
 			},
 		}}
 }
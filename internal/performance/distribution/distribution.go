@@ -0,0 +1,49 @@
+// Package distribution provides descriptive statistics of values obtained by repeated runs.
+package distribution
+
+import "math"
+
+// Distribution describes a sample of values.
+// StdDev is the population standard deviation of the sample.
+type Distribution struct {
+	Min    float64
+	Mean   float64
+	Max    float64
+	StdDev float64
+}
+
+// Describe computes Distribution of given values. It returns zero Distribution for an empty set of values.
+func Describe(values []float64) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+
+	d := Distribution{Min: values[0], Max: values[0]}
+
+	var total float64
+	for _, v := range values {
+		d.Min = math.Min(d.Min, v)
+		d.Max = math.Max(d.Max, v)
+		total += v
+	}
+
+	d.Mean = total / float64(len(values))
+
+	var squaredDiffs float64
+	for _, v := range values {
+		squaredDiffs += (v - d.Mean) * (v - d.Mean)
+	}
+
+	d.StdDev = math.Sqrt(squaredDiffs / float64(len(values)))
+
+	return d
+}
+
+// DescribeInts computes Distribution of given integer values.
+func DescribeInts(values []int) Distribution {
+	floats := make([]float64, len(values))
+	for i, v := range values {
+		floats[i] = float64(v)
+	}
+	return Describe(floats)
+}
@@ -0,0 +1,37 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should compute min, mean, max and standard deviation", func(t *testing.T) {
+		t.Parallel()
+
+		d := Describe([]float64{4, 2, 4, 4, 5, 5, 7, 9})
+
+		assert.Equal(t, Distribution{Min: 2, Mean: 5, Max: 9, StdDev: 2}, d)
+	})
+
+	t.Run("should handle single value", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, Distribution{Min: 3, Mean: 3, Max: 3}, Describe([]float64{3}))
+	})
+
+	t.Run("should return zero distribution for no values", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Zero(t, Describe(nil))
+	})
+}
+
+func TestDescribeInts(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Distribution{Min: 1, Mean: 2, Max: 3, StdDev: 1}, DescribeInts([]int{1, 3, 1, 3}))
+}
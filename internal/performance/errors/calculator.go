@@ -2,22 +2,32 @@ package errors
 
 import "math"
 
+// Reference represents the value that other values are compared with. It is either the optimal value
+// or a lower bound on it. Errors computed against a lower bound are gaps to the bound, so they are
+// upper bounds on errors computed against the optimal value.
+type Reference struct {
+	Value        int
+	IsLowerBound bool
+}
+
 // Info represents values related to an error computation
 // that are obtained based on original value and reference value.
 type Info struct {
-	Value          int
-	ReferenceValue int
-	AbsoluteError  int
-	RelativeError  float64
+	Value                 int
+	ReferenceValue        int
+	ReferenceIsLowerBound bool
+	AbsoluteError         int
+	RelativeError         float64
 }
 
 // Calculate calculate errors between original value and reference value.
-func Calculate(referenceValue, value int) *Info {
-	diff := int(math.Abs(float64(referenceValue - value)))
+func Calculate(reference Reference, value int) *Info {
+	diff := int(math.Abs(float64(reference.Value - value)))
 	return &Info{
-		Value:          value,
-		ReferenceValue: referenceValue,
-		AbsoluteError:  diff,
-		RelativeError:  float64(diff) / float64(referenceValue),
+		Value:                 value,
+		ReferenceValue:        reference.Value,
+		ReferenceIsLowerBound: reference.IsLowerBound,
+		AbsoluteError:         diff,
+		RelativeError:         float64(diff) / float64(reference.Value),
 	}
 }
@@ -7,8 +7,8 @@ import (
 
 func TestCalculate(t *testing.T) {
 	type args struct {
-		referenceValue int
-		value          int
+		reference Reference
+		value     int
 	}
 	tests := []struct {
 		name string
@@ -17,7 +17,7 @@ func TestCalculate(t *testing.T) {
 	}{
 		{
 			"should calculate info for referenceValue greater than value",
-			args{referenceValue: 12, value: 4},
+			args{reference: Reference{Value: 12}, value: 4},
 			&Info{
 				Value:          4,
 				ReferenceValue: 12,
@@ -27,7 +27,7 @@ func TestCalculate(t *testing.T) {
 		},
 		{
 			"should calculate info for referenceValue lower than value",
-			args{referenceValue: 3, value: 7},
+			args{reference: Reference{Value: 3}, value: 7},
 			&Info{
 				Value:          7,
 				ReferenceValue: 3,
@@ -37,7 +37,7 @@ func TestCalculate(t *testing.T) {
 		},
 		{
 			"should calculate info for referenceValue equal to value",
-			args{referenceValue: 4, value: 4},
+			args{reference: Reference{Value: 4}, value: 4},
 			&Info{
 				Value:          4,
 				ReferenceValue: 4,
@@ -45,10 +45,21 @@ func TestCalculate(t *testing.T) {
 				RelativeError:  0,
 			},
 		},
+		{
+			"should calculate gap to lower bound",
+			args{reference: Reference{Value: 4, IsLowerBound: true}, value: 5},
+			&Info{
+				Value:                 5,
+				ReferenceValue:        4,
+				ReferenceIsLowerBound: true,
+				AbsoluteError:         1,
+				RelativeError:         float64(1) / float64(4),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Calculate(tt.args.referenceValue, tt.args.value); !reflect.DeepEqual(got, tt.want) {
+			if got := Calculate(tt.args.reference, tt.args.value); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Calculate() = %v, want %v", got, tt.want)
 			}
 		})
@@ -3,9 +3,12 @@ package executor
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/internal/config"
 	"github.com/lothar1998/v2x-optimizer/internal/console"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
 	"golang.org/x/sys/execabs"
 )
 
@@ -17,10 +20,12 @@ const (
 
 type cplex struct {
 	processBuildFunc func(context.Context) Process
-	parseOutputFunc  func(string) (int, error)
+	parseOutputFunc  func(string) (*optimizer.Result, error)
+	validateFunc     func(dataFilepath string, result *optimizer.Result) error
 	modelFilepath    string
 	dataFilepath     string
 	threadPoolLimit  uint
+	measured         timing.Timing
 }
 
 // NewCplex returns Executor which is able to run cplex optimization process and obtain results from it.
@@ -33,7 +38,7 @@ func NewCplex(modelFilepath, dataFilepath string) Executor {
 // run on one machine to avoid excessive context switching.
 func NewCplexWithThreadPool(modelFilepath, dataFilepath string, threadPoolLimit uint) Executor {
 	c := &cplex{
-		parseOutputFunc: parseOutputFunc,
+		parseOutputFunc: console.FromOutput,
 		modelFilepath:   modelFilepath,
 		dataFilepath:    dataFilepath,
 		threadPoolLimit: threadPoolLimit,
@@ -42,16 +47,38 @@ func NewCplexWithThreadPool(modelFilepath, dataFilepath string, threadPoolLimit
 	return c
 }
 
+// NewCplexWithValidation returns Executor which works the same as the one returned by NewCplexWithThreadPool,
+// but additionally verifies that the result obtained from the CPLEX output is feasible for the given data.
+func NewCplexWithValidation(modelFilepath, dataFilepath string, threadPoolLimit uint) Executor {
+	c := NewCplexWithThreadPool(modelFilepath, dataFilepath, threadPoolLimit).(*cplex)
+	c.validateFunc = validateWithDataFile
+	return c
+}
+
 // Execute runs cplex optimization process in the background and waits for its results or context cancellation.
 func (c *cplex) Execute(ctx context.Context) (int, error) {
 	cmd := c.processBuildFunc(ctx)
 
+	start := time.Now()
 	bytes, err := cmd.Output()
+	c.measured = timing.Timing{WallTime: time.Since(start), CPUTime: cmd.CPUTime()}
+
 	if err != nil {
 		return 0, fmt.Errorf("CPLEX optimizer error: %w\n%v", err, string(bytes))
 	}
 
-	return c.parseOutputFunc(string(bytes))
+	result, err := c.parseOutputFunc(string(bytes))
+	if err != nil {
+		return 0, err
+	}
+
+	if c.validateFunc != nil {
+		if err := c.validateFunc(c.dataFilepath, result); err != nil {
+			return 0, err
+		}
+	}
+
+	return result.RRHCount, nil
 }
 
 // Identifier returns the name of cplex executor, which in this case is also the name of the underlying optimizer.
@@ -63,26 +90,35 @@ func (c *cplex) CacheEligible() bool {
 	return true
 }
 
+// MeasuredTiming returns the wall time and the CPU time consumed by the last CPLEX process.
+func (c *cplex) MeasuredTiming() timing.Timing {
+	return c.measured
+}
+
 func (c *cplex) buildProcess(ctx context.Context) Process {
-	return execabs.CommandContext(
+	return &process{execabs.CommandContext(
 		ctx,
 		cplexCommandDefault,
 		fmt.Sprintf("-Dthreads=%d", c.threadPoolLimit),
 		c.modelFilepath,
 		c.dataFilepath,
-	)
+	)}
 }
 
 // Process represents an external process.
+// CPUTime should return the user and system CPU time consumed by the process after it exited.
 type Process interface {
 	Output() ([]byte, error)
+	CPUTime() time.Duration
 }
 
-func parseOutputFunc(s string) (int, error) {
-	result, err := console.FromOutput(s)
-	if err != nil {
-		return 0, err
-	}
+type process struct {
+	*execabs.Cmd
+}
 
-	return result.RRHCount, nil
+func (p *process) CPUTime() time.Duration {
+	if p.ProcessState == nil {
+		return 0
+	}
+	return p.ProcessState.UserTime() + p.ProcessState.SystemTime()
 }
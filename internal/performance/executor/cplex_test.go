@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
 	executorMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/executor"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,9 +22,10 @@ func Test_cplex_Execute(t *testing.T) {
 
 		processMock := executorMock.NewMockProcess(gomock.NewController(t))
 		processMock.EXPECT().Output().Return([]byte{}, nil)
+		processMock.EXPECT().CPUTime().Return(time.Second)
 
-		parseOutput := func(output string) (int, error) {
-			return 10, nil
+		parseOutput := func(output string) (*optimizer.Result, error) {
+			return &optimizer.Result{RRHCount: 10}, nil
 		}
 
 		c := cplex{parseOutputFunc: parseOutput, processBuildFunc: buildProcessMock(processMock)}
@@ -31,6 +34,7 @@ func Test_cplex_Execute(t *testing.T) {
 
 		assert.Equal(t, expectedResult, result)
 		assert.NoError(t, err)
+		assert.Equal(t, time.Second, c.MeasuredTiming().CPUTime)
 	})
 
 	t.Run("should handle process output error", func(t *testing.T) {
@@ -40,9 +44,10 @@ func Test_cplex_Execute(t *testing.T) {
 
 		processMock := executorMock.NewMockProcess(gomock.NewController(t))
 		processMock.EXPECT().Output().Return(nil, expectedError)
+		processMock.EXPECT().CPUTime().Return(time.Second).AnyTimes()
 
 		c := cplex{
-			parseOutputFunc:  func(s string) (int, error) { return 0, nil },
+			parseOutputFunc:  func(s string) (*optimizer.Result, error) { return &optimizer.Result{}, nil },
 			processBuildFunc: buildProcessMock(processMock),
 		}
 
@@ -59,9 +64,58 @@ func Test_cplex_Execute(t *testing.T) {
 
 		processMock := executorMock.NewMockProcess(gomock.NewController(t))
 		processMock.EXPECT().Output().Return([]byte{}, nil)
+		processMock.EXPECT().CPUTime().Return(time.Second).AnyTimes()
 
 		c := cplex{
-			parseOutputFunc:  func(s string) (int, error) { return 0, expectedError },
+			parseOutputFunc:  func(s string) (*optimizer.Result, error) { return nil, expectedError },
+			processBuildFunc: buildProcessMock(processMock),
+		}
+
+		result, err := c.Execute(context.TODO())
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Zero(t, result)
+	})
+
+	t.Run("should validate result if validation is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResult := &optimizer.Result{RRHCount: 1}
+		expectedDataFilepath := "data-file"
+
+		processMock := executorMock.NewMockProcess(gomock.NewController(t))
+		processMock.EXPECT().Output().Return([]byte{}, nil)
+		processMock.EXPECT().CPUTime().Return(time.Second)
+
+		c := cplex{
+			parseOutputFunc: func(s string) (*optimizer.Result, error) { return expectedResult, nil },
+			validateFunc: func(dataFilepath string, result *optimizer.Result) error {
+				assert.Equal(t, expectedDataFilepath, dataFilepath)
+				assert.Equal(t, expectedResult, result)
+				return nil
+			},
+			processBuildFunc: buildProcessMock(processMock),
+			dataFilepath:     expectedDataFilepath,
+		}
+
+		result, err := c.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("should handle validation error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("test error")
+
+		processMock := executorMock.NewMockProcess(gomock.NewController(t))
+		processMock.EXPECT().Output().Return([]byte{}, nil)
+		processMock.EXPECT().CPUTime().Return(time.Second)
+
+		c := cplex{
+			parseOutputFunc:  func(s string) (*optimizer.Result, error) { return &optimizer.Result{RRHCount: 1}, nil },
+			validateFunc:     func(string, *optimizer.Result) error { return expectedError },
 			processBuildFunc: buildProcessMock(processMock),
 		}
 
@@ -3,21 +3,31 @@ package executor
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
 )
 
 // Custom is an Executor that allows for running optimization using the custom, self-written optimizer.
+// If Validate is set, the result of the optimizer is verified to be feasible for the given data.
 type Custom struct {
 	Path      string
 	Optimizer optimizer.PerformanceSubjectOptimizer
+	Validate  bool
+	measured  timing.Timing
 }
 
 func NewCustom(path string, optimizer optimizer.PerformanceSubjectOptimizer) Executor {
 	return &Custom{Path: path, Optimizer: optimizer}
 }
 
+// NewValidatedCustom returns Custom executor that verifies feasibility of results.
+func NewValidatedCustom(path string, optimizer optimizer.PerformanceSubjectOptimizer) Executor {
+	return &Custom{Path: path, Optimizer: optimizer, Validate: true}
+}
+
 // Execute runs optimization using custom optimizer and waits for results or context cancellation.
 func (c *Custom) Execute(ctx context.Context) (int, error) {
 	file, err := os.Open(c.Path)
@@ -31,11 +41,20 @@ func (c *Custom) Execute(ctx context.Context) (int, error) {
 		return 0, err
 	}
 
+	start := time.Now()
 	result, err := c.Optimizer.Optimize(ctx, decodedData)
+	c.measured = timing.Timing{WallTime: time.Since(start)}
+
 	if err != nil {
 		return 0, err
 	}
 
+	if c.Validate {
+		if err := validateResult(decodedData, result); err != nil {
+			return 0, err
+		}
+	}
+
 	return result.RRHCount, nil
 }
 
@@ -47,3 +66,8 @@ func (c *Custom) Identifier() string {
 func (c *Custom) CacheEligible() bool {
 	return c.Optimizer.CacheEligible()
 }
+
+// MeasuredTiming returns the wall time of the last optimization excluding the time of data decoding.
+func (c *Custom) MeasuredTiming() timing.Timing {
+	return c.measured
+}
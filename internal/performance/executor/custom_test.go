@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
@@ -37,6 +38,28 @@ func Test_custom_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should measure wall time of optimization", func(t *testing.T) {
+		t.Parallel()
+
+		filepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		optMock := optimizerMock.NewMockPerformanceSubjectOptimizer(gomock.NewController(t))
+		optMock.EXPECT().Optimize(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, *data.Data) (*optimizer.Result, error) {
+				time.Sleep(10 * time.Millisecond)
+				return &optimizer.Result{RRHCount: 1}, nil
+			})
+
+		c := Custom{Path: filepath, Optimizer: optMock}
+
+		_, err = c.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, c.MeasuredTiming().WallTime, 10*time.Millisecond)
+		assert.Zero(t, c.MeasuredTiming().CPUTime)
+	})
+
 	t.Run("should handle file error", func(t *testing.T) {
 		t.Parallel()
 
@@ -87,6 +110,48 @@ func Test_custom_Execute(t *testing.T) {
 		assert.Empty(t, result)
 	})
 
+	t.Run("should accept feasible result if validation is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		filepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		optMock := optimizerMock.NewMockPerformanceSubjectOptimizer(gomock.NewController(t))
+		optMock.EXPECT().Optimize(gomock.Any(), gomock.Any()).Return(&optimizer.Result{
+			RRHCount:                1,
+			RRHEnable:               []bool{false, false, false, false, true},
+			VehiclesToRRHAssignment: []int{4, 4},
+		}, nil)
+
+		c := NewValidatedCustom(filepath, optMock)
+
+		result, err := c.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("should return error for infeasible result if validation is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		filepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		optMock := optimizerMock.NewMockPerformanceSubjectOptimizer(gomock.NewController(t))
+		optMock.EXPECT().Optimize(gomock.Any(), gomock.Any()).Return(&optimizer.Result{
+			RRHCount:                1,
+			RRHEnable:               []bool{true, false, false, false, false},
+			VehiclesToRRHAssignment: []int{0, 0},
+		}, nil)
+
+		c := NewValidatedCustom(filepath, optMock)
+
+		result, err := c.Execute(context.TODO())
+
+		assert.ErrorIs(t, err, optimizer.ErrInvalidResult)
+		assert.Zero(t, result)
+	})
+
 	t.Run("should handle context cancellation", func(t *testing.T) {
 		t.Parallel()
 
@@ -1,10 +1,16 @@
 package executor
 
-import "context"
+import (
+	"context"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+)
 
 type Dummy struct {
-	Name   string
-	Result int
+	Name    string
+	Result  int
+	Timing  timing.Timing
+	Samples []int
 }
 
 func (d *Dummy) Identifier() string {
@@ -18,3 +24,11 @@ func (d *Dummy) Execute(_ context.Context) (int, error) {
 func (d *Dummy) CacheEligible() bool {
 	return false
 }
+
+func (d *Dummy) MeasuredTiming() timing.Timing {
+	return d.Timing
+}
+
+func (d *Dummy) MeasuredSamples() []int {
+	return d.Samples
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lothar1998/v2x-optimizer/internal/behavior"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 )
 
 type Executor interface {
@@ -11,3 +12,22 @@ type Executor interface {
 	behavior.Cacheable
 	Execute(ctx context.Context) (int, error)
 }
+
+// TimingReporter should be implemented by the Executor that measures time of its last execution on its own,
+// e.g. if it runs an external process or its result is already known. Otherwise, only the wall time
+// of the whole Execute call is measured.
+type TimingReporter interface {
+	MeasuredTiming() timing.Timing
+}
+
+// SamplesReporter should be implemented by the Executor that obtains several values within a single execution,
+// e.g. by running a non-deterministic optimizer repeatedly.
+type SamplesReporter interface {
+	MeasuredSamples() []int
+}
+
+// Repeater should be implemented by the Executor that runs the optimization several times within a single
+// execution. The time limit of a single run is multiplied by the number of repetitions in such a case.
+type Repeater interface {
+	Repetitions() int
+}
@@ -0,0 +1,199 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+	"github.com/lothar1998/v2x-optimizer/internal/solution"
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"golang.org/x/sys/execabs"
+)
+
+const (
+	modelFilePlaceholder    = "{model_file}"
+	solutionFilePlaceholder = "{solution_file}"
+	threadsPlaceholder      = "{threads}"
+
+	modelFileName    = "model"
+	solutionFileName = "model.sol"
+)
+
+var errEmptyCommandTemplate = errors.New("empty command template")
+
+// ModelFormat is a format of the binary program passed to an external solver.
+// Extension is required, since solvers usually recognize the format of the model by the file extension.
+type ModelFormat struct {
+	Encoder   data.Encoder
+	Extension string
+}
+
+var (
+	// LPModelFormat passes the binary program to an external solver in CPLEX LP format.
+	LPModelFormat = ModelFormat{encoder.LP{}, ".lp"}
+	// MPSModelFormat passes the binary program to an external solver in free MPS format.
+	MPSModelFormat = ModelFormat{encoder.MPS{}, ".mps"}
+)
+
+// ExternalSolver describes an external MIP solver, e.g. CBC, HiGHS or SCIP, which solves the binary program
+// defined by v2x-optimization.mod exported in ModelFormat. CommandTemplate is split into arguments on whitespaces
+// and the following placeholders are replaced in each of them:
+// {model_file} - the path to the exported model,
+// {solution_file} - the path to which the solver should write the solution,
+// {threads} - ThreadLimit (0 means the default configuration of the solver).
+// For example, CBC can be run using "cbc {model_file} threads {threads} solve solu {solution_file}".
+// If the template doesn't contain {solution_file}, the standard output of the solver is parsed instead.
+// ParseSolution parses the solution, it defaults to solution.FromSol if nil.
+// Results are identified by Identifier, so they are cached separately for each command template and model format.
+type ExternalSolver struct {
+	Name            string
+	CommandTemplate string
+	ModelFormat     ModelFormat
+	ThreadLimit     uint
+	ParseSolution   func(content string, vehicleCount, rrhCount int) (*optimizer.Result, error)
+}
+
+// Identifier returns Name along with CommandTemplate and the extension of ModelFormat.
+func (s ExternalSolver) Identifier() string {
+	return fmt.Sprintf("%s,command:%s,format:%s",
+		s.Name, s.CommandTemplate, strings.TrimPrefix(s.ModelFormat.Extension, "."))
+}
+
+type externalSolver struct {
+	processBuildFunc func(ctx context.Context, args []string) Process
+	solver           ExternalSolver
+	dataFilepath     string
+	validate         bool
+	measured         timing.Timing
+}
+
+// NewExternalSolver returns Executor which is able to run an external solver process
+// and obtain results from the solution written by it.
+func NewExternalSolver(solver ExternalSolver, dataFilepath string) Executor {
+	if solver.ParseSolution == nil {
+		solver.ParseSolution = solution.FromSol
+	}
+
+	return &externalSolver{
+		processBuildFunc: buildProcess,
+		solver:           solver,
+		dataFilepath:     dataFilepath,
+	}
+}
+
+// NewExternalSolverWithValidation returns Executor which works the same as the one returned by NewExternalSolver,
+// but additionally verifies that the solution written by the solver is feasible for the given data.
+func NewExternalSolverWithValidation(solver ExternalSolver, dataFilepath string) Executor {
+	e := NewExternalSolver(solver, dataFilepath).(*externalSolver)
+	e.validate = true
+	return e
+}
+
+// Execute exports the model of the data file to a temporary directory, runs the solver process
+// and waits for its results or context cancellation.
+func (e *externalSolver) Execute(ctx context.Context) (int, error) {
+	decodedData, err := decodeDataFile(e.dataFilepath)
+	if err != nil {
+		return 0, err
+	}
+
+	dir, err := ioutil.TempDir("", "v2x-optimizer-performance-solver-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+
+	modelFilepath := filepath.Join(dir, modelFileName+e.solver.ModelFormat.Extension)
+	solutionFilepath := filepath.Join(dir, solutionFileName)
+
+	if err := e.writeModel(decodedData, modelFilepath); err != nil {
+		return 0, err
+	}
+
+	args := e.commandArgs(modelFilepath, solutionFilepath)
+	if len(args) == 0 {
+		return 0, errEmptyCommandTemplate
+	}
+
+	cmd := e.processBuildFunc(ctx, args)
+
+	start := time.Now()
+	output, err := cmd.Output()
+	e.measured = timing.Timing{WallTime: time.Since(start), CPUTime: cmd.CPUTime()}
+
+	if err != nil {
+		return 0, fmt.Errorf("%s solver error: %w\n%v", e.solver.Name, err, string(output))
+	}
+
+	if strings.Contains(e.solver.CommandTemplate, solutionFilePlaceholder) {
+		output, err = ioutil.ReadFile(solutionFilepath)
+		if err != nil {
+			return 0, fmt.Errorf("%s solver hasn't written solution: %w", e.solver.Name, err)
+		}
+	}
+
+	result, err := e.solver.ParseSolution(string(output), len(decodedData.R), len(decodedData.MRB))
+	if err != nil {
+		return 0, err
+	}
+
+	if e.validate {
+		if err := validateResult(decodedData, result); err != nil {
+			return 0, err
+		}
+	}
+
+	return result.RRHCount, nil
+}
+
+// Identifier returns the identifier of the external solver, see ExternalSolver.Identifier.
+func (e *externalSolver) Identifier() string {
+	return e.solver.Identifier()
+}
+
+func (e *externalSolver) CacheEligible() bool {
+	return true
+}
+
+// MeasuredTiming returns the wall time and the CPU time consumed by the last solver process.
+func (e *externalSolver) MeasuredTiming() timing.Timing {
+	return e.measured
+}
+
+func (e *externalSolver) writeModel(decodedData *data.Data, modelFilepath string) error {
+	file, err := os.Create(modelFilepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return e.solver.ModelFormat.Encoder.Encode(decodedData, file)
+}
+
+func (e *externalSolver) commandArgs(modelFilepath, solutionFilepath string) []string {
+	replacer := strings.NewReplacer(
+		modelFilePlaceholder, modelFilepath,
+		solutionFilePlaceholder, solutionFilepath,
+		threadsPlaceholder, strconv.FormatUint(uint64(e.solver.ThreadLimit), 10),
+	)
+
+	args := strings.Fields(e.solver.CommandTemplate)
+	for i := range args {
+		args[i] = replacer.Replace(args[i])
+	}
+
+	return args
+}
+
+func buildProcess(ctx context.Context, args []string) Process {
+	return &process{execabs.CommandContext(ctx, args[0], args[1:]...)}
+}
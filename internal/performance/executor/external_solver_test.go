@@ -0,0 +1,222 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_externalSolver_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return value of solution written by solver", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, `test -s "$1" || exit 1
+printf 'Optimal - objective value 1\n 0 x_4 1 1\n 1 y_0_4 1 0\n 2 y_1_4 1 0\n' > "$2"`)
+
+		solver := ExternalSolver{
+			Name:            "Stub",
+			CommandTemplate: script + " {model_file} {solution_file}",
+			ModelFormat:     LPModelFormat,
+		}
+
+		e := NewExternalSolverWithValidation(solver, dataFilepath)
+		result, err := e.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, "Stub,command:"+script+" {model_file} {solution_file},format:lp", e.Identifier())
+		assert.True(t, e.CacheEligible())
+	})
+
+	t.Run("should parse standard output if solution file is not used", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, `case "$1" in *.mps) ;; *) exit 1 ;; esac
+test "$2" = 4 || exit 1
+echo "y_0_3 1"
+echo "y_1_4 1"`)
+
+		solver := ExternalSolver{
+			Name:            "Stub",
+			CommandTemplate: script + " {model_file} {threads}",
+			ModelFormat:     MPSModelFormat,
+			ThreadLimit:     4,
+		}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("should use given solution parser", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, `echo "RRH_COUNT = 1"`)
+
+		var parsedContent string
+		solver := ExternalSolver{
+			Name:            "Stub",
+			CommandTemplate: script,
+			ModelFormat:     LPModelFormat,
+			ParseSolution: func(content string, vehicleCount, rrhCount int) (*optimizer.Result, error) {
+				parsedContent = content
+				return &optimizer.Result{RRHCount: 1}, nil
+			},
+		}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, "RRH_COUNT = 1\n", parsedContent)
+	})
+
+	t.Run("should return error if solution is infeasible and validation is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, `printf 'y_0_0 1\ny_1_0 1\n' > "$1"`)
+
+		solver := ExternalSolver{
+			Name:            "Stub",
+			CommandTemplate: script + " {solution_file}",
+			ModelFormat:     LPModelFormat,
+		}
+
+		result, err := NewExternalSolverWithValidation(solver, dataFilepath).Execute(context.TODO())
+
+		assert.ErrorIs(t, err, optimizer.ErrInvalidResult)
+		assert.Zero(t, result)
+	})
+
+	t.Run("should return error if solver process fails", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, "exit 3")
+
+		solver := ExternalSolver{Name: "Stub", CommandTemplate: script, ModelFormat: LPModelFormat}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		var exitError interface{ ExitCode() int }
+		assert.True(t, errors.As(err, &exitError))
+		assert.Zero(t, result)
+	})
+
+	t.Run("should return error if solver doesn't write solution", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		script := setupSolverScript(t, "exit 0")
+
+		solver := ExternalSolver{
+			Name:            "Stub",
+			CommandTemplate: script + " {solution_file}",
+			ModelFormat:     LPModelFormat,
+		}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		assert.Zero(t, result)
+	})
+
+	t.Run("should return error if command template is empty", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		solver := ExternalSolver{Name: "Stub", CommandTemplate: " ", ModelFormat: LPModelFormat}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		assert.ErrorIs(t, err, errEmptyCommandTemplate)
+		assert.Zero(t, result)
+	})
+
+	t.Run("should return error if data file is malformed", func(t *testing.T) {
+		t.Parallel()
+
+		dataFilepath, err := setupDataFile(false)
+		assert.NoError(t, err)
+
+		solver := ExternalSolver{Name: "Stub", CommandTemplate: "true", ModelFormat: LPModelFormat}
+
+		result, err := NewExternalSolver(solver, dataFilepath).Execute(context.TODO())
+
+		assert.Error(t, err)
+		assert.Zero(t, result)
+	})
+}
+
+func Test_externalSolver_commandArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should replace placeholders in command template", func(t *testing.T) {
+		t.Parallel()
+
+		e := &externalSolver{solver: ExternalSolver{
+			CommandTemplate: "cbc  {model_file} threads {threads} solve solu {solution_file}",
+			ThreadLimit:     2,
+		}}
+
+		args := e.commandArgs("/tmp/model.lp", "/tmp/model.sol")
+
+		assert.Equal(t, []string{"cbc", "/tmp/model.lp", "threads", "2", "solve", "solu", "/tmp/model.sol"}, args)
+	})
+}
+
+func TestExternalSolver_Identifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should distinguish solvers by command template and model format", func(t *testing.T) {
+		t.Parallel()
+
+		cbc := ExternalSolver{Name: "ExternalSolver", CommandTemplate: "cbc {model_file}", ModelFormat: LPModelFormat}
+		highs := ExternalSolver{Name: "ExternalSolver", CommandTemplate: "highs {model_file}", ModelFormat: LPModelFormat}
+		cbcWithMPS := ExternalSolver{Name: "ExternalSolver", CommandTemplate: "cbc {model_file}", ModelFormat: MPSModelFormat}
+
+		assert.Equal(t, "ExternalSolver,command:cbc {model_file},format:lp", cbc.Identifier())
+		assert.NotEqual(t, cbc.Identifier(), highs.Identifier())
+		assert.NotEqual(t, cbc.Identifier(), cbcWithMPS.Identifier())
+		assert.Equal(t, cbc.Identifier(), NewExternalSolverReference(cbc).Name)
+	})
+}
+
+// setupSolverScript writes shell script with the given body that stands in for an external solver.
+func setupSolverScript(t *testing.T, body string) string {
+	t.Helper()
+
+	script := filepath.Join(t.TempDir(), "solver.sh")
+
+	err := ioutil.WriteFile(script, []byte("#!/bin/sh\n"+body+"\n"), 0755)
+	assert.NoError(t, err)
+
+	return script
+}
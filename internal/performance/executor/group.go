@@ -2,42 +2,77 @@ package executor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 )
 
+// Result represents the outcome of a single execution. Samples are set only
+// if the executor implements SamplesReporter.
 type Result struct {
 	Executor
-	Value int
-	Err   error
+	Value   int
+	Timing  timing.Timing
+	Samples []int
+	Err     error
 }
 
+// GroupExecutor runs all executors concurrently. If Timeout is greater than zero,
+// each executor gets its own deadline derived from the given context.
 type GroupExecutor struct {
 	Executors []Executor
+	Timeout   time.Duration
 }
 
 func (ge *GroupExecutor) Execute(ctx context.Context) <-chan *Result {
 	results := make([]<-chan *Result, 0)
 
 	for _, executor := range ge.Executors {
-		results = append(results, execute(ctx, executor))
+		results = append(results, execute(ctx, executor, ge.Timeout))
 	}
 
 	return merge(results...)
 }
 
-func execute(ctx context.Context, executor Executor) <-chan *Result {
+func execute(ctx context.Context, executor Executor, timeout time.Duration) <-chan *Result {
 	resultCh := make(chan *Result)
 
 	go func() {
 		defer close(resultCh)
 
-		result, err := executor.Execute(ctx)
+		if repeater, ok := executor.(Repeater); ok {
+			timeout *= time.Duration(repeater.Repetitions())
+		}
+
+		executorCtx := ctx
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			executorCtx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		start := time.Now()
+		result, err := executor.Execute(executorCtx)
+		measuredTiming := timing.Timing{WallTime: time.Since(start)}
+
 		if err != nil {
-			resultCh <- &Result{Executor: executor, Err: err}
+			resultCh <- &Result{Executor: executor, Err: withDeadlineCause(executorCtx, err)}
 			return
 		}
 
-		resultCh <- &Result{Executor: executor, Value: result}
+		if reporter, ok := executor.(TimingReporter); ok {
+			measuredTiming = reporter.MeasuredTiming()
+		}
+
+		var samples []int
+		if reporter, ok := executor.(SamplesReporter); ok {
+			samples = reporter.MeasuredSamples()
+		}
+
+		resultCh <- &Result{Executor: executor, Value: result, Timing: measuredTiming, Samples: samples}
 	}()
 
 	return resultCh
@@ -65,3 +100,13 @@ func merge(channels ...<-chan *Result) <-chan *Result {
 
 	return out
 }
+
+// withDeadlineCause wraps the error with context.DeadlineExceeded if the deadline of the context
+// has been exceeded, but the executor reported the error in a different way,
+// e.g. an external process has been killed.
+func withDeadlineCause(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) && !errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %s", context.DeadlineExceeded, err)
+	}
+	return err
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 	executorMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/executor"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,7 +21,7 @@ func TestGroupExecutor_Execute(t *testing.T) {
 		executor := executorMock.NewMockExecutor(gomock.NewController(t))
 		executor.EXPECT().Execute(gomock.Any()).Return(5, nil).Times(1)
 
-		e := GroupExecutor{[]Executor{executor}}
+		e := GroupExecutor{Executors: []Executor{executor}}
 
 		results := e.Execute(context.TODO())
 
@@ -48,13 +50,13 @@ func TestGroupExecutor_Execute(t *testing.T) {
 		executorMock1.EXPECT().Execute(gomock.Any()).Return(2, nil).Times(1)
 		executorMock2.EXPECT().Execute(gomock.Any()).Return(13, nil).Times(1)
 
-		e := GroupExecutor{[]Executor{executorMock1, executorMock2}}
+		e := GroupExecutor{Executors: []Executor{executorMock1, executorMock2}}
 
 		results := e.Execute(context.TODO())
 
 		count := 0
 		for result := range results {
-			assert.Contains(t, expectedResults, result)
+			assertContainsIgnoringTiming(t, expectedResults, result)
 			count++
 		}
 		assert.Equal(t, 2, count)
@@ -80,22 +82,126 @@ func TestGroupExecutor_Execute(t *testing.T) {
 			{Executor: executorMock3, Value: 21, Err: nil},
 		}
 
-		e := GroupExecutor{[]Executor{executorMock1, executorMock2, executorMock3}}
+		e := GroupExecutor{Executors: []Executor{executorMock1, executorMock2, executorMock3}}
 
 		results := e.Execute(context.TODO())
 
 		count := 0
 		for result := range results {
-			assert.Contains(t, expectedResults, result)
+			assertContainsIgnoringTiming(t, expectedResults, result)
 			count++
 		}
 		assert.Equal(t, 3, count)
 	})
 
+	t.Run("should use timing reported by executor", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTiming := timing.Timing{WallTime: time.Minute, CPUTime: 2 * time.Minute}
+
+		executor := &Dummy{Name: "dummy", Result: 3, Timing: expectedTiming}
+
+		e := GroupExecutor{Executors: []Executor{executor}}
+
+		results := e.Execute(context.TODO())
+
+		count := 0
+		for result := range results {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, 3, result.Value)
+			assert.Equal(t, expectedTiming, result.Timing)
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should limit time of each executor if timeout is set", func(t *testing.T) {
+		t.Parallel()
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		executor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(ctx context.Context) (int, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+			return 1, nil
+		})
+
+		e := GroupExecutor{Executors: []Executor{executor}, Timeout: time.Minute}
+
+		count := 0
+		for result := range e.Execute(context.TODO()) {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, 1, result.Value)
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should multiply timeout by number of repetitions and pass samples", func(t *testing.T) {
+		t.Parallel()
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		executor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(ctx context.Context) (int, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(3*time.Minute), deadline, 10*time.Second)
+			return 1, nil
+		}).Times(3)
+
+		e := GroupExecutor{Executors: []Executor{NewRepeated(executor, 3)}, Timeout: time.Minute}
+
+		count := 0
+		for result := range e.Execute(context.TODO()) {
+			assert.NoError(t, result.Err)
+			assert.Equal(t, []int{1, 1, 1}, result.Samples)
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should report deadline exceeded if executor was stopped by timeout", func(t *testing.T) {
+		t.Parallel()
+
+		killedError := errors.New("signal: killed")
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		executor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, killedError
+		})
+
+		e := GroupExecutor{Executors: []Executor{executor}, Timeout: time.Millisecond}
+
+		count := 0
+		for result := range e.Execute(context.TODO()) {
+			assert.ErrorIs(t, result.Err, context.DeadlineExceeded)
+			assert.Contains(t, result.Err.Error(), killedError.Error())
+			count++
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("should not set deadline if timeout is not set", func(t *testing.T) {
+		t.Parallel()
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		executor.EXPECT().Execute(gomock.Any()).DoAndReturn(func(ctx context.Context) (int, error) {
+			_, ok := ctx.Deadline()
+			assert.False(t, ok)
+			return 1, nil
+		})
+
+		e := GroupExecutor{Executors: []Executor{executor}}
+
+		for result := range e.Execute(context.TODO()) {
+			assert.NoError(t, result.Err)
+		}
+	})
+
 	t.Run("should return no results for empty list of executors", func(t *testing.T) {
 		t.Parallel()
 
-		e := GroupExecutor{[]Executor{}}
+		e := GroupExecutor{Executors: []Executor{}}
 
 		results := e.Execute(context.TODO())
 
@@ -109,7 +215,7 @@ func TestGroupExecutor_Execute(t *testing.T) {
 	t.Run("should no results if executors are undefined", func(t *testing.T) {
 		t.Parallel()
 
-		e := GroupExecutor{nil}
+		e := GroupExecutor{}
 
 		results := e.Execute(context.TODO())
 
@@ -132,7 +238,7 @@ func Test_execute(t *testing.T) {
 		executor := executorMock.NewMockExecutor(gomock.NewController(t))
 		executor.EXPECT().Execute(gomock.Any()).Return(expectedResult, nil).Times(1)
 
-		result := execute(context.TODO(), executor)
+		result := execute(context.TODO(), executor, 0)
 
 		count := 0
 		for v := range result {
@@ -152,7 +258,7 @@ func Test_execute(t *testing.T) {
 		executor := executorMock.NewMockExecutor(gomock.NewController(t))
 		executor.EXPECT().Execute(gomock.Any()).Return(0, expectedError).Times(1)
 
-		result := execute(context.TODO(), executor)
+		result := execute(context.TODO(), executor, 0)
 
 		count := 0
 		for v := range result {
@@ -164,3 +270,11 @@ func Test_execute(t *testing.T) {
 		assert.Equal(t, 1, count)
 	})
 }
+
+// assertContainsIgnoringTiming asserts that the result is among expected ones.
+// Measured timing depends on the machine, so it is not compared.
+func assertContainsIgnoringTiming(t *testing.T, expectedResults []*Result, result *Result) {
+	withoutTiming := *result
+	withoutTiming.Timing = timing.Timing{}
+	assert.Contains(t, expectedResults, &withoutTiming)
+}
@@ -0,0 +1,89 @@
+package executor
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+)
+
+// ErrUnknownValue is returned if there is no known value for the given data file.
+var ErrUnknownValue = errors.New("unknown value for data file")
+
+// KnownValues maps data files to the best known values of the objective function for them.
+type KnownValues map[string]int
+
+// LoadKnownValues reads KnownValues from CSV file consisting of lines in the form of "data_file,value".
+// The data file may be defined either as a path or as a bare filename.
+func LoadKnownValues(path string) (KnownValues, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return decodeKnownValues(file)
+}
+
+func decodeKnownValues(r io.Reader) (KnownValues, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	values := make(KnownValues)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return values, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		value, err := strconv.Atoi(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse value of %s: %w", record[0], err)
+		}
+
+		values[filepath.Clean(record[0])] = value
+	}
+}
+
+type known struct {
+	dataFilepath string
+	values       KnownValues
+}
+
+// NewKnown returns Executor that doesn't optimize anything but returns the known value for the given data file.
+func NewKnown(dataFilepath string, values KnownValues) Executor {
+	return &known{dataFilepath: dataFilepath, values: values}
+}
+
+// Execute looks up the known value using the whole data file path first and the filename only next.
+// It returns ErrUnknownValue if the value is not defined.
+func (k *known) Execute(_ context.Context) (int, error) {
+	if value, ok := k.values[filepath.Clean(k.dataFilepath)]; ok {
+		return value, nil
+	}
+
+	if value, ok := k.values[filepath.Base(k.dataFilepath)]; ok {
+		return value, nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrUnknownValue, k.dataFilepath)
+}
+
+func (k *known) Identifier() string {
+	return config.KnownValuesReferenceName
+}
+
+// CacheEligible returns false since the values are already stored in a file.
+func (k *known) CacheEligible() bool {
+	return false
+}
@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadKnownValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should load known values from CSV file", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := ioutil.TempFile("", "v2x-optimizer-performance-known-values-*")
+		assert.NoError(t, err)
+		_, err = file.WriteString("data_0.v2x,12\n/data/dir/data_1.v2x, 7\n")
+		assert.NoError(t, err)
+		_ = file.Close()
+
+		values, err := LoadKnownValues(file.Name())
+
+		assert.NoError(t, err)
+		assert.Equal(t, KnownValues{"data_0.v2x": 12, "/data/dir/data_1.v2x": 7}, values)
+	})
+
+	t.Run("should handle malformed value", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := decodeKnownValues(strings.NewReader("data_0.v2x,abc\n"))
+
+		assert.Error(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("should handle malformed line", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := decodeKnownValues(strings.NewReader("data_0.v2x,1,2\n"))
+
+		assert.Error(t, err)
+		assert.Nil(t, values)
+	})
+}
+
+func Test_known_Execute(t *testing.T) {
+	t.Parallel()
+
+	values := KnownValues{"data_0.v2x": 12, "/data/dir/data_1.v2x": 7}
+
+	t.Run("should return value defined by the whole path", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := NewKnown("/data/dir/data_1.v2x", values).Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result)
+	})
+
+	t.Run("should return value defined by filename", func(t *testing.T) {
+		t.Parallel()
+
+		k := NewKnown("/data/dir/data_0.v2x", values)
+		result, err := k.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, result)
+		assert.Equal(t, config.KnownValuesReferenceName, k.Identifier())
+		assert.False(t, k.CacheEligible())
+	})
+
+	t.Run("should return error if value is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := NewKnown("/data/dir/data_2.v2x", values).Execute(context.TODO())
+
+		assert.ErrorIs(t, err, ErrUnknownValue)
+		assert.Zero(t, result)
+	})
+}
@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"context"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/pkg/bound"
+)
+
+type lowerBound struct {
+	dataFilepath string
+}
+
+// NewLowerBound returns Executor that doesn't optimize anything but computes bound.Best
+// lower bound on the number of RRHs for the given data file.
+func NewLowerBound(dataFilepath string) Executor {
+	return &lowerBound{dataFilepath: dataFilepath}
+}
+
+func (l *lowerBound) Execute(_ context.Context) (int, error) {
+	decodedData, err := decodeDataFile(l.dataFilepath)
+	if err != nil {
+		return 0, err
+	}
+
+	return bound.Best(decodedData), nil
+}
+
+func (l *lowerBound) Identifier() string {
+	return config.LowerBoundReferenceName
+}
+
+// CacheEligible returns true since the bound depends only on the data.
+func (l *lowerBound) CacheEligible() bool {
+	return true
+}
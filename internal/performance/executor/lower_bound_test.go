@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_lowerBound_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return lower bound of data file", func(t *testing.T) {
+		t.Parallel()
+
+		filepath, err := setupDataFile(true)
+		assert.NoError(t, err)
+
+		e := NewLowerBound(filepath)
+		result, err := e.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, config.LowerBoundReferenceName, e.Identifier())
+		assert.True(t, e.CacheEligible())
+	})
+
+	t.Run("should return error if data file is malformed", func(t *testing.T) {
+		t.Parallel()
+
+		filepath, err := setupDataFile(false)
+		assert.NoError(t, err)
+
+		_, err = NewLowerBound(filepath).Execute(context.TODO())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if data file doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewLowerBound("/not/existing/file").Execute(context.TODO())
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,83 @@
+package executor
+
+import (
+	"github.com/lothar1998/v2x-optimizer/internal/config"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+)
+
+// Reference describes the baseline executor, i.e. the one whose results are used
+// as reference values for the results of all other executors.
+// Name has to be equal to the Identifier of executors returned by Build and BuildValidated.
+// BuildValidated returns executors that additionally verify feasibility of results if it is possible.
+// IsLowerBound is set if the reference provides lower bounds on the optimal values instead of the values themselves.
+type Reference struct {
+	Name           string
+	Build          func(dataPath string) Executor
+	BuildValidated func(dataPath string) Executor
+	IsLowerBound   bool
+}
+
+// NewCplexReference returns Reference that uses CPLEX optimization process as the baseline.
+func NewCplexReference(modelFilepath string, threadPoolLimit uint) Reference {
+	return Reference{
+		Name: config.CPLEXOptimizerName,
+		Build: func(dataPath string) Executor {
+			return NewCplexWithThreadPool(modelFilepath, dataPath, threadPoolLimit)
+		},
+		BuildValidated: func(dataPath string) Executor {
+			return NewCplexWithValidation(modelFilepath, dataPath, threadPoolLimit)
+		},
+	}
+}
+
+// NewCustomReference returns Reference that uses the given optimizer as the baseline.
+// It is convenient in case of exact optimizers that run in-process.
+func NewCustomReference(optimizer optimizer.PerformanceSubjectOptimizer) Reference {
+	return Reference{
+		Name: optimizer.Identifier(),
+		Build: func(dataPath string) Executor {
+			return NewCustom(dataPath, optimizer)
+		},
+		BuildValidated: func(dataPath string) Executor {
+			return NewValidatedCustom(dataPath, optimizer)
+		},
+	}
+}
+
+// NewKnownValuesReference returns Reference that uses already known values as the baseline.
+// Known values are not accompanied by any solution, so there is nothing to validate.
+func NewKnownValuesReference(values KnownValues) Reference {
+	build := func(dataPath string) Executor {
+		return NewKnown(dataPath, values)
+	}
+
+	return Reference{
+		Name:           config.KnownValuesReferenceName,
+		Build:          build,
+		BuildValidated: build,
+	}
+}
+
+// NewLowerBoundReference returns Reference that uses lower bounds on the optimal values as the baseline.
+// It is convenient in case of instances too big to be solved exactly.
+func NewLowerBoundReference() Reference {
+	return Reference{
+		Name:           config.LowerBoundReferenceName,
+		Build:          NewLowerBound,
+		BuildValidated: NewLowerBound,
+		IsLowerBound:   true,
+	}
+}
+
+// NewExternalSolverReference returns Reference that uses the given external MIP solver as the baseline.
+func NewExternalSolverReference(solver ExternalSolver) Reference {
+	return Reference{
+		Name: solver.Identifier(),
+		Build: func(dataPath string) Executor {
+			return NewExternalSolver(solver, dataPath)
+		},
+		BuildValidated: func(dataPath string) Executor {
+			return NewExternalSolverWithValidation(solver, dataPath)
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"context"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+)
+
+// Repeated is an Executor that runs the underlying executor Count times in a row. It is intended
+// for non-deterministic optimizers, whose single run says little about their quality.
+// The value of the execution is the value of the first run, so it is comparable with a single execution.
+// Values of all runs are reported as samples.
+type Repeated struct {
+	Executor
+	Count    int
+	samples  []int
+	measured timing.Timing
+}
+
+// NewRepeated returns Repeated executor that runs the given executor count times.
+func NewRepeated(executor Executor, count int) Executor {
+	return &Repeated{Executor: executor, Count: count}
+}
+
+// Execute runs the underlying executor Count times. It fails as soon as any of the runs fails.
+func (r *Repeated) Execute(ctx context.Context) (int, error) {
+	samples := make([]int, 0, r.Count)
+	var total timing.Timing
+
+	for i := 0; i < r.Count; i++ {
+		start := time.Now()
+		value, err := r.Executor.Execute(ctx)
+		measured := timing.Timing{WallTime: time.Since(start)}
+
+		if err != nil {
+			return 0, err
+		}
+
+		if reporter, ok := r.Executor.(TimingReporter); ok {
+			measured = reporter.MeasuredTiming()
+		}
+
+		total.WallTime += measured.WallTime
+		total.CPUTime += measured.CPUTime
+		samples = append(samples, value)
+	}
+
+	r.samples = samples
+	r.measured = timing.Timing{
+		WallTime: total.WallTime / time.Duration(r.Count),
+		CPUTime:  total.CPUTime / time.Duration(r.Count),
+	}
+
+	return samples[0], nil
+}
+
+// CacheEligible returns true, since the distribution of values is worth caching
+// even though a single value of a non-deterministic optimizer is not.
+func (r *Repeated) CacheEligible() bool {
+	return true
+}
+
+// MeasuredTiming returns the average timing of a single run.
+func (r *Repeated) MeasuredTiming() timing.Timing {
+	return r.measured
+}
+
+// MeasuredSamples returns values of all runs of the last execution.
+func (r *Repeated) MeasuredSamples() []int {
+	return r.samples
+}
+
+// Repetitions returns the number of runs within a single execution.
+func (r *Repeated) Repetitions() int {
+	return r.Count
+}
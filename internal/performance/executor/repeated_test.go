@@ -0,0 +1,80 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+	executorMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/executor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepeated_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should run executor given number of times and report all values", func(t *testing.T) {
+		t.Parallel()
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		gomock.InOrder(
+			executor.EXPECT().Execute(gomock.Any()).Return(4, nil),
+			executor.EXPECT().Execute(gomock.Any()).Return(3, nil),
+			executor.EXPECT().Execute(gomock.Any()).Return(5, nil),
+		)
+
+		r := NewRepeated(executor, 3).(*Repeated)
+
+		value, err := r.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, value)
+		assert.Equal(t, []int{4, 3, 5}, r.MeasuredSamples())
+		assert.Equal(t, 3, r.Repetitions())
+	})
+
+	t.Run("should report average timing of single run", func(t *testing.T) {
+		t.Parallel()
+
+		executor := &Dummy{Name: "dummy", Result: 1, Timing: timing.Timing{WallTime: time.Second, CPUTime: time.Minute}}
+
+		r := NewRepeated(executor, 4).(*Repeated)
+
+		_, err := r.Execute(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, timing.Timing{WallTime: time.Second, CPUTime: time.Minute}, r.MeasuredTiming())
+		assert.Equal(t, []int{1, 1, 1, 1}, r.MeasuredSamples())
+	})
+
+	t.Run("should return error as soon as any run fails", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("test error")
+
+		executor := executorMock.NewMockExecutor(gomock.NewController(t))
+		gomock.InOrder(
+			executor.EXPECT().Execute(gomock.Any()).Return(4, nil),
+			executor.EXPECT().Execute(gomock.Any()).Return(0, expectedError),
+		)
+
+		value, err := NewRepeated(executor, 3).Execute(context.TODO())
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Zero(t, value)
+	})
+}
+
+func TestRepeated_Identifier(t *testing.T) {
+	t.Parallel()
+
+	executor := executorMock.NewMockExecutor(gomock.NewController(t))
+	executor.EXPECT().Identifier().Return("optimizer")
+
+	r := NewRepeated(executor, 2)
+
+	assert.Equal(t, "optimizer", r.Identifier())
+	assert.True(t, r.CacheEligible())
+}
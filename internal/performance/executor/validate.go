@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/data/encoder"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+)
+
+func validateWithDataFile(dataFilepath string, result *optimizer.Result) error {
+	decodedData, err := decodeDataFile(dataFilepath)
+	if err != nil {
+		return err
+	}
+
+	return validateResult(decodedData, result)
+}
+
+func validateResult(data *data.Data, result *optimizer.Result) error {
+	if err := optimizer.Validate(data, result); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	return nil
+}
+
+func decodeDataFile(dataFilepath string) (*data.Data, error) {
+	file, err := os.Open(dataFilepath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return encoder.CPLEX{}.Decode(file)
+}
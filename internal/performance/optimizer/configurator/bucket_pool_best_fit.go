@@ -2,6 +2,7 @@ package configurator
 
 import (
 	"errors"
+	"math/rand"
 
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
 	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bucketpoolbestfit"
@@ -51,6 +52,11 @@ func (b BucketPoolBestFitConfigurator) Builder() BuildFunc {
 			return nil, err
 		}
 
+		source, err := randomSource(command)
+		if err != nil {
+			return nil, err
+		}
+
 		bpbf := BucketPoolBestFitWrapper{
 			Name:                bucketPoolBestFitName,
 			FitnessFuncID:       int(fitnessID),
@@ -58,7 +64,7 @@ func (b BucketPoolBestFitConfigurator) Builder() BuildFunc {
 			InitPoolSize:        int(initPoolSize),
 			BucketPoolBestFit: bucketpoolbestfit.BucketPoolBestFit{
 				InitPoolSize:       int(initPoolSize),
-				ReorderBucketsFunc: bucketPoolBestFitToBucketReorderFunc(bucketReorderID),
+				ReorderBucketsFunc: intToBucketReorderFunc(bucketReorderID, source),
 				FitnessFunc:        intToFitness(fitnessID),
 			},
 		}
@@ -95,7 +101,7 @@ func (b BucketPoolBestFitConfigurator) TypeName() string {
 	return bucketPoolBestFitName
 }
 
-func bucketPoolBestFitToBucketReorderFunc(intValue uint) helper.ReorderBucketsFunc {
+func intToBucketReorderFunc(intValue uint, source rand.Source) helper.ReorderBucketsFunc {
 	switch intValue {
 	case 0:
 		return helper.NoOpReorder
@@ -104,7 +110,7 @@ func bucketPoolBestFitToBucketReorderFunc(intValue uint) helper.ReorderBucketsFu
 	case 2:
 		return helper.DescendingBucketSizeReorder
 	case 3:
-		return helper.RandomReorder
+		return helper.NewRandomReorder(source)
 	default:
 		return nil
 	}
@@ -0,0 +1,108 @@
+package configurator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/genetic"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/spf13/cobra"
+)
+
+const (
+	geneticParameterPopulationSize = "ga_population"
+	geneticParameterGenerations    = "ga_generations"
+	geneticParameterMutationRate   = "ga_mutation_rate"
+	geneticParameterWorkers        = "ga_workers"
+	geneticParameterTimeLimit      = "ga_time_limit"
+	geneticName                    = "Genetic"
+)
+
+type GeneticWrapper struct {
+	Name           string        `id_name:""`
+	PopulationSize int           `id_include:"true"`
+	Generations    int           `id_include:"true"`
+	MutationRate   float64       `id_include:"true"`
+	TimeLimit      time.Duration `id_include:"true"`
+	genetic.Genetic
+}
+
+type GeneticConfigurator struct{}
+
+func (g GeneticConfigurator) Builder() BuildFunc {
+	return func(command *cobra.Command) (optimizer.PerformanceSubjectOptimizer, error) {
+		populationSize, err := command.Flags().GetUint(geneticParameterPopulationSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if populationSize < 2 {
+			return nil, errors.New("population size has to be at least 2")
+		}
+
+		generations, err := command.Flags().GetUint(geneticParameterGenerations)
+		if err != nil {
+			return nil, err
+		}
+
+		mutationRate, err := command.Flags().GetFloat64(geneticParameterMutationRate)
+		if err != nil {
+			return nil, err
+		}
+
+		if mutationRate < 0 || mutationRate > 1 {
+			return nil, errors.New("mutation rate has to be in range [0, 1]")
+		}
+
+		workers, err := command.Flags().GetUint(geneticParameterWorkers)
+		if err != nil {
+			return nil, err
+		}
+
+		timeLimit, err := command.Flags().GetDuration(geneticParameterTimeLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := randomSource(command)
+		if err != nil {
+			return nil, err
+		}
+
+		ga := &GeneticWrapper{
+			Name:           geneticName,
+			PopulationSize: int(populationSize),
+			Generations:    int(generations),
+			MutationRate:   mutationRate,
+			TimeLimit:      timeLimit,
+			Genetic: genetic.Genetic{
+				PopulationSize: int(populationSize),
+				Generations:    int(generations),
+				MutationRate:   mutationRate,
+				Workers:        int(workers),
+				TimeLimit:      timeLimit,
+				Source:         helper.NewLockedSource(source),
+			},
+		}
+
+		return optimizer.NewPerformanceSubjectAdapter(ga, false), nil
+	}
+}
+
+func (g GeneticConfigurator) SetUpFlags(command *cobra.Command) {
+	command.Flags().UintP(geneticParameterPopulationSize, "", 30,
+		"Genetic number of individuals in population")
+	command.Flags().UintP(geneticParameterGenerations, "", 200,
+		"Genetic maximal number of generations")
+	command.Flags().Float64P(geneticParameterMutationRate, "", 0.2,
+		"Genetic probability of mutation of offspring")
+	command.Flags().UintP(geneticParameterWorkers, "", 0,
+		"Genetic number of goroutines evaluating offspring (0 - number of CPUs)")
+	command.Flags().DurationP(geneticParameterTimeLimit, "", 0,
+		"Genetic time limit, e.g. 500ms (0 - no limit)")
+}
+
+func (g GeneticConfigurator) TypeName() string {
+	return geneticName
+}
@@ -0,0 +1,41 @@
+package configurator
+
+import (
+	pkgOptimizer "github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bucketorientedfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/nextfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/worstfit"
+)
+
+const maxInitialOptimizerID = 4
+
+// initialOptimizerUsage describes values accepted by flags that define the constructive heuristic
+// providing the initial solution for improvement optimizers.
+const initialOptimizerUsage = "\t0 - FirstFit\n" +
+	"\t1 - BestFit with classic fitness function\n" +
+	"\t2 - BucketOrientedFit with no op bucket reorder and ascending order of items\n" +
+	"\t3 - NextFit\n" +
+	"\t4 - WorstFit\n"
+
+func intToInitialOptimizer(intValue uint) pkgOptimizer.Optimizer {
+	switch intValue {
+	case 0:
+		return firstfit.FirstFit{}
+	case 1:
+		return bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}
+	case 2:
+		return bucketorientedfit.BucketOrientedFit{
+			ReorderBucketsByItemsFunc: helper.NoOpReorderByItems,
+			ItemOrderComparatorFunc:   bucketorientedfit.AscendingItemSize,
+		}
+	case 3:
+		return nextfit.NextFit{}
+	case 4:
+		return worstfit.WorstFit{}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package configurator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/ruinandrecreate"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ruinAndRecreateParameterInitialOptimizerID      = "rr_init"
+	ruinAndRecreateParameterRuinedBuckets           = "rr_ruined"
+	ruinAndRecreateParameterIterations              = "rr_iterations"
+	ruinAndRecreateParameterFunctionID              = "rr_fit"
+	ruinAndRecreateParameterBucketReorderFunctionID = "rr_bucket_reorder_fun"
+	ruinAndRecreateParameterTimeLimit               = "rr_time_limit"
+	ruinAndRecreateName                             = "RuinAndRecreate"
+)
+
+type RuinAndRecreateWrapper struct {
+	Name                string        `id_name:""`
+	InitialOptimizerID  int           `id_include:"true"`
+	RuinedBuckets       int           `id_include:"true"`
+	Iterations          int           `id_include:"true"`
+	FitnessFuncID       int           `id_include:"true"`
+	BucketReorderFuncID int           `id_include:"true"`
+	TimeLimit           time.Duration `id_include:"true"`
+	ruinandrecreate.RuinAndRecreate
+}
+
+type RuinAndRecreateConfigurator struct{}
+
+func (r RuinAndRecreateConfigurator) Builder() BuildFunc {
+	return func(command *cobra.Command) (optimizer.PerformanceSubjectOptimizer, error) {
+		initialOptimizerID, err := command.Flags().GetUint(ruinAndRecreateParameterInitialOptimizerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if initialOptimizerID > maxInitialOptimizerID {
+			return nil, errors.New("unsupported initial optimizer")
+		}
+
+		ruinedBuckets, err := command.Flags().GetUint(ruinAndRecreateParameterRuinedBuckets)
+		if err != nil {
+			return nil, err
+		}
+
+		if ruinedBuckets < 1 {
+			return nil, errors.New("number of ruined buckets has to be greater than 0")
+		}
+
+		iterations, err := command.Flags().GetUint(ruinAndRecreateParameterIterations)
+		if err != nil {
+			return nil, err
+		}
+
+		timeLimit, err := command.Flags().GetDuration(ruinAndRecreateParameterTimeLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		if iterations == 0 && timeLimit <= 0 {
+			return nil, errors.New("either number of iterations or time limit has to be greater than 0")
+		}
+
+		fitnessID, err := command.Flags().GetUint(ruinAndRecreateParameterFunctionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if fitnessID > 6 {
+			return nil, errors.New("unsupported fitness function")
+		}
+
+		bucketReorderID, err := command.Flags().GetUint(ruinAndRecreateParameterBucketReorderFunctionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if bucketReorderID > 3 {
+			return nil, errors.New("unsupported bucket reorder function")
+		}
+
+		source, err := randomSource(command)
+		if err != nil {
+			return nil, err
+		}
+
+		source = helper.NewLockedSource(source)
+
+		rr := &RuinAndRecreateWrapper{
+			Name:                ruinAndRecreateName,
+			InitialOptimizerID:  int(initialOptimizerID),
+			RuinedBuckets:       int(ruinedBuckets),
+			Iterations:          int(iterations),
+			FitnessFuncID:       int(fitnessID),
+			BucketReorderFuncID: int(bucketReorderID),
+			TimeLimit:           timeLimit,
+			RuinAndRecreate: ruinandrecreate.RuinAndRecreate{
+				InitialOptimizer:   intToInitialOptimizer(initialOptimizerID),
+				RuinedBuckets:      int(ruinedBuckets),
+				Iterations:         int(iterations),
+				TimeLimit:          timeLimit,
+				Source:             source,
+				ReorderBucketsFunc: intToBucketReorderFunc(bucketReorderID, source),
+				FitnessFunc:        intToFitness(fitnessID),
+			},
+		}
+
+		return optimizer.NewPerformanceSubjectAdapter(rr, false), nil
+	}
+}
+
+func (r RuinAndRecreateConfigurator) SetUpFlags(command *cobra.Command) {
+	command.Flags().UintP(ruinAndRecreateParameterInitialOptimizerID, "", 1,
+		"RuinAndRecreate optimizer providing the initial solution:\n"+initialOptimizerUsage)
+	command.Flags().UintP(ruinAndRecreateParameterRuinedBuckets, "", 3,
+		"RuinAndRecreate number of the least loaded buckets closed in each iteration")
+	command.Flags().UintP(ruinAndRecreateParameterIterations, "", 1000,
+		"RuinAndRecreate maximal number of iterations (0 - run until the time limit given by "+
+			ruinAndRecreateParameterTimeLimit+")")
+	command.Flags().UintP(ruinAndRecreateParameterFunctionID, "", 0,
+		"RuinAndRecreate fitness function used to reassign items:\n"+
+			"\t0 - classic fitness function\n"+
+			"\t1 - take into account bucket size\n"+
+			"\t2 - take into account left space in bucket and prefer big items\n"+
+			"\t3 - take into account left space in bucket and prefer small items\n"+
+			"\t4 - take into account left space in bucket and prefer small items and punish perfectly fitted items\n"+
+			"\t5 - take into account left space in bucket and prefer as little space left as possible"+
+			" before and after item assignment\n"+
+			"\t6 - take into account left space in bucket and prefer as little space left as possible"+
+			" before and after item assignment and punish perfectly fitted items\n"+
+			"\t(default 0)")
+	command.Flags().UintP(ruinAndRecreateParameterBucketReorderFunctionID, "", 3,
+		"RuinAndRecreate bucket reorder function (defines order in which closed buckets are opened):\n"+
+			"\t0 - no op (order defined by input data)\n"+
+			"\t1 - sort buckets in ascending order by left space\n"+
+			"\t2 - sort buckets in descending order by left space\n"+
+			"\t3 - random order\n")
+	command.Flags().DurationP(ruinAndRecreateParameterTimeLimit, "", 0,
+		"RuinAndRecreate time limit, e.g. 500ms (0 - no limit)")
+}
+
+func (r RuinAndRecreateConfigurator) TypeName() string {
+	return ruinAndRecreateName
+}
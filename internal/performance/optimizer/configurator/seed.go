@@ -0,0 +1,32 @@
+package configurator
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// SeedFlag is the name of the optional flag that defines the seed of randomized optimizers.
+// If the command doesn't define the flag or the user doesn't specify it, the seed is based on the current time.
+const SeedFlag = "seed"
+
+// SetUpSeedFlag registers SeedFlag in the command.
+func SetUpSeedFlag(command *cobra.Command) {
+	command.Flags().Int64P(SeedFlag, "", 0,
+		"seed of randomized optimizers (if not specified, the seed is based on the current time)")
+}
+
+func randomSource(command *cobra.Command) (rand.Source, error) {
+	flag := command.Flags().Lookup(SeedFlag)
+	if flag == nil || !flag.Changed {
+		return rand.NewSource(time.Now().UnixNano()), nil
+	}
+
+	seed, err := command.Flags().GetInt64(SeedFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	return rand.NewSource(seed), nil
+}
@@ -0,0 +1,141 @@
+package configurator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/annealing"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/spf13/cobra"
+)
+
+const (
+	simulatedAnnealingParameterInitialOptimizerID = "sa_init"
+	simulatedAnnealingParameterIterations         = "sa_iterations"
+	simulatedAnnealingParameterTemperature        = "sa_temperature"
+	simulatedAnnealingParameterCoolingID          = "sa_cooling"
+	simulatedAnnealingParameterCoolingRate        = "sa_cooling_rate"
+	simulatedAnnealingParameterTimeLimit          = "sa_time_limit"
+	simulatedAnnealingName                        = "SimulatedAnnealing"
+)
+
+type SimulatedAnnealingWrapper struct {
+	Name               string        `id_name:""`
+	InitialOptimizerID int           `id_include:"true"`
+	Iterations         int           `id_include:"true"`
+	Temperature        float64       `id_include:"true"`
+	CoolingID          int           `id_include:"true"`
+	CoolingRate        float64       `id_include:"true"`
+	TimeLimit          time.Duration `id_include:"true"`
+	annealing.SimulatedAnnealing
+}
+
+type SimulatedAnnealingConfigurator struct{}
+
+func (s SimulatedAnnealingConfigurator) Builder() BuildFunc {
+	return func(command *cobra.Command) (optimizer.PerformanceSubjectOptimizer, error) {
+		initialOptimizerID, err := command.Flags().GetUint(simulatedAnnealingParameterInitialOptimizerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if initialOptimizerID > maxInitialOptimizerID {
+			return nil, errors.New("unsupported initial optimizer")
+		}
+
+		iterations, err := command.Flags().GetUint(simulatedAnnealingParameterIterations)
+		if err != nil {
+			return nil, err
+		}
+
+		temperature, err := command.Flags().GetFloat64(simulatedAnnealingParameterTemperature)
+		if err != nil {
+			return nil, err
+		}
+
+		if temperature < 0 {
+			return nil, errors.New("temperature cannot be negative")
+		}
+
+		coolingID, err := command.Flags().GetUint(simulatedAnnealingParameterCoolingID)
+		if err != nil {
+			return nil, err
+		}
+
+		if coolingID > 1 {
+			return nil, errors.New("unsupported cooling schedule")
+		}
+
+		coolingRate, err := command.Flags().GetFloat64(simulatedAnnealingParameterCoolingRate)
+		if err != nil {
+			return nil, err
+		}
+
+		if coolingRate <= 0 || coolingRate >= 1 {
+			return nil, errors.New("cooling rate has to be in range (0, 1)")
+		}
+
+		timeLimit, err := command.Flags().GetDuration(simulatedAnnealingParameterTimeLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := randomSource(command)
+		if err != nil {
+			return nil, err
+		}
+
+		sa := &SimulatedAnnealingWrapper{
+			Name:               simulatedAnnealingName,
+			InitialOptimizerID: int(initialOptimizerID),
+			Iterations:         int(iterations),
+			Temperature:        temperature,
+			CoolingID:          int(coolingID),
+			CoolingRate:        coolingRate,
+			TimeLimit:          timeLimit,
+			SimulatedAnnealing: annealing.SimulatedAnnealing{
+				InitialOptimizer: intToInitialOptimizer(initialOptimizerID),
+				Schedule:         simulatedAnnealingToCoolingSchedule(coolingID, temperature, coolingRate),
+				Iterations:       int(iterations),
+				TimeLimit:        timeLimit,
+				Source:           helper.NewLockedSource(source),
+			},
+		}
+
+		return optimizer.NewPerformanceSubjectAdapter(sa, false), nil
+	}
+}
+
+func (s SimulatedAnnealingConfigurator) SetUpFlags(command *cobra.Command) {
+	command.Flags().UintP(simulatedAnnealingParameterInitialOptimizerID, "", 1,
+		"SimulatedAnnealing optimizer providing the initial solution:\n"+initialOptimizerUsage)
+	command.Flags().UintP(simulatedAnnealingParameterIterations, "", 100000,
+		"SimulatedAnnealing maximal number of iterations")
+	command.Flags().Float64P(simulatedAnnealingParameterTemperature, "", 1,
+		"SimulatedAnnealing initial temperature")
+	command.Flags().UintP(simulatedAnnealingParameterCoolingID, "", 0,
+		"SimulatedAnnealing cooling schedule:\n"+
+			"\t0 - geometric (temperature is multiplied by cooling rate in each iteration)\n"+
+			"\t1 - linear (temperature decreases linearly to zero in the last iteration)\n"+
+			"\t(default 0)")
+	command.Flags().Float64P(simulatedAnnealingParameterCoolingRate, "", 0.9999,
+		"SimulatedAnnealing cooling rate of geometric cooling schedule")
+	command.Flags().DurationP(simulatedAnnealingParameterTimeLimit, "", 0,
+		"SimulatedAnnealing time limit, e.g. 500ms (0 - no limit)")
+}
+
+func (s SimulatedAnnealingConfigurator) TypeName() string {
+	return simulatedAnnealingName
+}
+
+func simulatedAnnealingToCoolingSchedule(intValue uint, temperature, coolingRate float64) annealing.CoolingSchedule {
+	switch intValue {
+	case 0:
+		return annealing.GeometricCooling(temperature, coolingRate)
+	case 1:
+		return annealing.LinearCooling(temperature)
+	default:
+		return nil
+	}
+}
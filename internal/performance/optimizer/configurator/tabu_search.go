@@ -0,0 +1,88 @@
+package configurator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/tabu"
+	"github.com/spf13/cobra"
+)
+
+const (
+	tabuSearchParameterInitialOptimizerID = "ts_init"
+	tabuSearchParameterIterations         = "ts_iterations"
+	tabuSearchParameterTenure             = "ts_tenure"
+	tabuSearchParameterTimeLimit          = "ts_time_limit"
+	tabuSearchName                        = "TabuSearch"
+)
+
+type TabuSearchWrapper struct {
+	Name               string        `id_name:""`
+	InitialOptimizerID int           `id_include:"true"`
+	Iterations         int           `id_include:"true"`
+	Tenure             int           `id_include:"true"`
+	TimeLimit          time.Duration `id_include:"true"`
+	tabu.TabuSearch
+}
+
+type TabuSearchConfigurator struct{}
+
+func (t TabuSearchConfigurator) Builder() BuildFunc {
+	return func(command *cobra.Command) (optimizer.PerformanceSubjectOptimizer, error) {
+		initialOptimizerID, err := command.Flags().GetUint(tabuSearchParameterInitialOptimizerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if initialOptimizerID > maxInitialOptimizerID {
+			return nil, errors.New("unsupported initial optimizer")
+		}
+
+		iterations, err := command.Flags().GetUint(tabuSearchParameterIterations)
+		if err != nil {
+			return nil, err
+		}
+
+		tenure, err := command.Flags().GetUint(tabuSearchParameterTenure)
+		if err != nil {
+			return nil, err
+		}
+
+		timeLimit, err := command.Flags().GetDuration(tabuSearchParameterTimeLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		ts := &TabuSearchWrapper{
+			Name:               tabuSearchName,
+			InitialOptimizerID: int(initialOptimizerID),
+			Iterations:         int(iterations),
+			Tenure:             int(tenure),
+			TimeLimit:          timeLimit,
+			TabuSearch: tabu.TabuSearch{
+				InitialOptimizer: intToInitialOptimizer(initialOptimizerID),
+				Iterations:       int(iterations),
+				Tenure:           int(tenure),
+				TimeLimit:        timeLimit,
+			},
+		}
+
+		return optimizer.NewPerformanceSubjectAdapter(ts, timeLimit == 0), nil
+	}
+}
+
+func (t TabuSearchConfigurator) SetUpFlags(command *cobra.Command) {
+	command.Flags().UintP(tabuSearchParameterInitialOptimizerID, "", 1,
+		"TabuSearch optimizer providing the initial solution:\n"+initialOptimizerUsage)
+	command.Flags().UintP(tabuSearchParameterIterations, "", 1000,
+		"TabuSearch maximal number of iterations")
+	command.Flags().UintP(tabuSearchParameterTenure, "", 7,
+		"TabuSearch number of iterations during which an item cannot come back to the bucket it left")
+	command.Flags().DurationP(tabuSearchParameterTimeLimit, "", 0,
+		"TabuSearch time limit, e.g. 500ms (0 - no limit)")
+}
+
+func (t TabuSearchConfigurator) TypeName() string {
+	return tabuSearchName
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner/path"
@@ -38,6 +39,31 @@ func NewRunnerWithLimits(
 	}
 }
 
+func NewRunnerWithReference(
+	dataPaths []string,
+	optimizers []optimizer.PerformanceSubjectOptimizer,
+	reference executor.Reference,
+) *Runner {
+	return &Runner{
+		PathRunner: path.NewRunnerWithReference(reference, optimizers),
+		DataPaths:  dataPaths,
+	}
+}
+
+// NewRunnerWithOptions returns Runner that computes results of optimizers along with results of the given reference
+// for all data paths and behaves according to the given options.
+func NewRunnerWithOptions(
+	dataPaths []string,
+	optimizers []optimizer.PerformanceSubjectOptimizer,
+	reference executor.Reference,
+	options runner.Options,
+) *Runner {
+	return &Runner{
+		PathRunner: path.NewRunnerWithOptions(reference, optimizers, options),
+		DataPaths:  dataPaths,
+	}
+}
+
 func (p *Runner) Run(ctx context.Context) (runner.PathsToResults, error) {
 	results := make([]<-chan *runner.PathResult, 0)
 
@@ -24,7 +24,7 @@ func TestRunner_Run(t *testing.T) {
 			Path: dataPath1,
 			FilesToResults: runner.FilesToResults{
 				"f1": runner.OptimizersToResults{
-					"o1": 1,
+					"o1": {Value: 1},
 				},
 			},
 		}
@@ -33,7 +33,7 @@ func TestRunner_Run(t *testing.T) {
 			Path: dataPath2,
 			FilesToResults: runner.FilesToResults{
 				"f2": runner.OptimizersToResults{
-					"o1": 2,
+					"o1": {Value: 2},
 				},
 			},
 		}
@@ -77,7 +77,7 @@ func TestRunner_Run(t *testing.T) {
 			Path: dataPath1,
 			FilesToResults: runner.FilesToResults{
 				"f1": runner.OptimizersToResults{
-					"o1": 1,
+					"o1": {Value: 1},
 				},
 			},
 		}
@@ -120,7 +120,7 @@ func Test_mergePathResults(t *testing.T) {
 			Path: "path1",
 			FilesToResults: runner.FilesToResults{
 				"f1": runner.OptimizersToResults{
-					"o1": 1,
+					"o1": {Value: 1},
 				},
 			},
 		}
@@ -129,7 +129,7 @@ func Test_mergePathResults(t *testing.T) {
 			Path: "path2",
 			FilesToResults: runner.FilesToResults{
 				"f2": runner.OptimizersToResults{
-					"o1": 2,
+					"o1": {Value: 2},
 				},
 			},
 		}
@@ -2,15 +2,19 @@ package file
 
 import (
 	"context"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
 )
 
-type Runner struct{}
+// Runner runs executors for a single file. If Timeout is greater than zero, it limits the time of each execution.
+type Runner struct {
+	Timeout time.Duration
+}
 
 func (fr *Runner) Run(ctx context.Context, executors []executor.Executor, file string) <-chan *runner.FileResult {
-	group := executor.GroupExecutor{Executors: executors}
+	group := executor.GroupExecutor{Executors: executors, Timeout: fr.Timeout}
 	return enrichWithFilename(group.Execute(ctx), file)
 }
 
@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+)
+
+// Outcome represents the way the execution of an optimizer for a single file ended.
+type Outcome int
+
+const (
+	OutcomeSuccess Outcome = iota
+	OutcomeInfeasible
+	OutcomeError
+	OutcomeTimeout
+)
+
+// OutcomeOf classifies the error returned by the executor. The lack of error means success,
+// optimizer.ErrCannotAssignToBucket means that the optimizer could not find any feasible solution,
+// and exceeding the deadline of the context means timeout. Any other error is treated as a generic error.
+func OutcomeOf(err error) Outcome {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, optimizer.ErrCannotAssignToBucket):
+		return OutcomeInfeasible
+	case errors.Is(err, context.DeadlineExceeded):
+		return OutcomeTimeout
+	default:
+		return OutcomeError
+	}
+}
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSuccess:
+		return "success"
+	case OutcomeInfeasible:
+		return "infeasible"
+	case OutcomeError:
+		return "error"
+	case OutcomeTimeout:
+		return "timeout"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,42 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/internal/solution"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomeOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want Outcome
+	}{
+		{"should return success if there is no error", nil, OutcomeSuccess},
+		{"should return infeasible for cannot assign error", optimizer.ErrCannotAssignToBucket, OutcomeInfeasible},
+		{
+			"should return infeasible for wrapped cannot assign error",
+			fmt.Errorf("wrapped: %w", optimizer.ErrCannotAssignToBucket),
+			OutcomeInfeasible,
+		},
+		{"should return infeasible for infeasibility reported by solver", solution.ErrInfeasible, OutcomeInfeasible},
+		{"should return timeout for exceeded deadline", context.DeadlineExceeded, OutcomeTimeout},
+		{"should return error for any other error", errors.New("test error"), OutcomeError},
+		{"should return error for cancellation", context.Canceled, OutcomeError},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, OutcomeOf(tt.err))
+		})
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
-	"github.com/lothar1998/v2x-optimizer/internal/config"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/cache"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
@@ -17,7 +17,7 @@ import (
 
 type viewBuildFunc func(string) (view.DirectoryView, error)
 
-type cplexExecutorBuildFunc func(modelPath, dataPath string) executor.Executor
+type referenceExecutorBuildFunc func(dataPath string) executor.Executor
 
 type optimizerExecutorBuildFunc func(
 	dataPath string,
@@ -35,7 +35,6 @@ type runForFileWithCacheFunc func(
 ) <-chan *runner.FileResult
 
 type pathRunner struct {
-	modelPath  string
 	optimizers []optimizer.PerformanceSubjectOptimizer
 
 	runner.FileRunner
@@ -45,17 +44,19 @@ type pathRunner struct {
 	directoryViewBuildFunc viewBuildFunc
 	fileViewBuildFunc      viewBuildFunc
 
-	cplexExecutorBuildFunc
-	cplexOptimizerName string
+	referenceExecutorBuildFunc
+	referenceName string
 
 	optimizerExecutorBuildFunc
 
+	runner.Options
+
 	runForDirFunc
 	runForFileWithCacheFunc
 }
 
 func NewRunner(cplexModelFile string, optimizers []optimizer.PerformanceSubjectOptimizer) runner.PathRunner {
-	return newRunnerWithCplexBuilder(cplexModelFile, optimizers, executor.NewCplex)
+	return NewRunnerWithReference(executor.NewCplexReference(cplexModelFile, 0), optimizers)
 }
 
 func NewRunnerWithLimits(
@@ -63,24 +64,43 @@ func NewRunnerWithLimits(
 	optimizers []optimizer.PerformanceSubjectOptimizer,
 	cplexThreads uint,
 ) runner.PathRunner {
-	return newRunnerWithCplexBuilder(cplexModelFile, optimizers, getModelExecutorBuilderWithThreadPool(cplexThreads))
+	return NewRunnerWithReference(executor.NewCplexReference(cplexModelFile, cplexThreads), optimizers)
 }
 
-func newRunnerWithCplexBuilder(
-	cplexModelFile string,
+// NewRunnerWithReference returns runner.PathRunner that computes results of optimizers
+// along with results of the given reference.
+func NewRunnerWithReference(
+	reference executor.Reference,
+	optimizers []optimizer.PerformanceSubjectOptimizer,
+) runner.PathRunner {
+	return NewRunnerWithOptions(reference, optimizers, runner.Options{})
+}
+
+// NewRunnerWithOptions returns runner.PathRunner that computes results of optimizers
+// along with results of the given reference and behaves according to the given options.
+func NewRunnerWithOptions(
+	reference executor.Reference,
 	optimizers []optimizer.PerformanceSubjectOptimizer,
-	cplexExecutorBuilder cplexExecutorBuildFunc,
+	options runner.Options,
 ) runner.PathRunner {
+	buildReference := reference.Build
+	buildOptimizer := executor.NewCustom
+
+	if options.ValidateResults {
+		buildReference = reference.BuildValidated
+		buildOptimizer = executor.NewValidatedCustom
+	}
+
 	r := &pathRunner{
-		modelPath:                  cplexModelFile,
 		optimizers:                 optimizers,
-		FileRunner:                 &file.Runner{},
+		FileRunner:                 &file.Runner{Timeout: options.TimeoutPerRun},
 		cacheLoadFunc:              cache.Load,
-		directoryViewBuildFunc:     buildDirectoryViewWithoutCacheFile,
+		directoryViewBuildFunc:     buildDirectoryViewWithoutHiddenFiles,
 		fileViewBuildFunc:          view.NewFile,
-		cplexExecutorBuildFunc:     cplexExecutorBuilder,
-		cplexOptimizerName:         config.CPLEXOptimizerName,
-		optimizerExecutorBuildFunc: executor.NewCustom,
+		referenceExecutorBuildFunc: buildReference,
+		referenceName:              reference.Name,
+		optimizerExecutorBuildFunc: buildOptimizer,
+		Options:                    options,
 	}
 	r.runForDirFunc = r.runForDir
 	r.runForFileWithCacheFunc = r.runForFileWithCache
@@ -144,6 +164,8 @@ func (pr *pathRunner) runForDir(ctx context.Context, view view.DirectoryView) (r
 		switch {
 		case result.Err != nil:
 			err = result.Err
+		case result.Result.Err != nil && pr.isRecordedAsOutcome(result.Result.Err):
+			executionResults = append(executionResults, result)
 		case result.Result.Err != nil:
 			err = result.Result.Err
 		default:
@@ -169,6 +191,12 @@ func (pr *pathRunner) runForDir(ctx context.Context, view view.DirectoryView) (r
 	return toFilesToResults(executionResults), nil
 }
 
+// isRecordedAsOutcome returns true if the error of the executor should be recorded as the outcome of the file
+// instead of aborting the run. Timeouts are always recorded, so exceeding time limits doesn't discard other results.
+func (pr *pathRunner) isRecordedAsOutcome(err error) bool {
+	return pr.ContinueOnError || runner.OutcomeOf(err) == runner.OutcomeTimeout
+}
+
 func (pr *pathRunner) runForFileWithCache(
 	ctx context.Context,
 	localCache cache.Cache,
@@ -215,10 +243,10 @@ func (pr *pathRunner) runForFileWithCache(
 
 func (pr *pathRunner) getAllExecutors(dataPath string) []executor.Executor {
 	var executors []executor.Executor
-	executors = append(executors, pr.cplexExecutorBuildFunc(pr.modelPath, dataPath))
+	executors = append(executors, pr.referenceExecutorBuildFunc(dataPath))
 
 	for _, opt := range pr.optimizers {
-		executors = append(executors, pr.optimizerExecutorBuildFunc(dataPath, opt))
+		executors = append(executors, pr.buildOptimizerExecutor(dataPath, opt))
 	}
 
 	return executors
@@ -227,23 +255,52 @@ func (pr *pathRunner) getAllExecutors(dataPath string) []executor.Executor {
 func (pr *pathRunner) getNotCachedExecutors(dataPath string, info *cache.FileInfo) []executor.Executor {
 	var executors []executor.Executor
 
-	if value, isCached := info.Results[pr.cplexOptimizerName]; isCached {
-		executors = append(executors, &executor.Dummy{Name: pr.cplexOptimizerName, Result: value})
+	if value, isCached := info.Results[pr.referenceName]; isCached {
+		executors = append(executors,
+			&executor.Dummy{Name: pr.referenceName, Result: value, Timing: info.Timings[pr.referenceName]})
 	} else {
-		executors = append(executors, pr.cplexExecutorBuildFunc(pr.modelPath, dataPath))
+		executors = append(executors, pr.referenceExecutorBuildFunc(dataPath))
 	}
 
 	for _, opt := range pr.optimizers {
 		if value, isCached := info.Results[opt.Identifier()]; isCached {
-			executors = append(executors, &executor.Dummy{Name: opt.Identifier(), Result: value})
+			executors = append(executors,
+				&executor.Dummy{Name: opt.Identifier(), Result: value, Timing: info.Timings[opt.Identifier()]})
+		} else if samples, isCached := info.Samples[opt.Identifier()]; isCached && pr.isRepeated(opt) &&
+			len(samples) >= pr.Repeat {
+			executors = append(executors, &executor.Dummy{
+				Name:    opt.Identifier(),
+				Result:  samples[0],
+				Timing:  info.Timings[opt.Identifier()],
+				Samples: samples[:pr.Repeat],
+			})
 		} else {
-			executors = append(executors, pr.optimizerExecutorBuildFunc(dataPath, opt))
+			executors = append(executors, pr.buildOptimizerExecutor(dataPath, opt))
 		}
 	}
 
 	return executors
 }
 
+// buildOptimizerExecutor builds the executor of the optimizer, which runs the optimizer repeatedly
+// if the optimizer is non-deterministic and repetitions are requested.
+func (pr *pathRunner) buildOptimizerExecutor(
+	dataPath string,
+	opt optimizer.PerformanceSubjectOptimizer,
+) executor.Executor {
+	e := pr.optimizerExecutorBuildFunc(dataPath, opt)
+
+	if pr.isRepeated(opt) {
+		return executor.NewRepeated(e, pr.Repeat)
+	}
+
+	return e
+}
+
+func (pr *pathRunner) isRepeated(opt optimizer.PerformanceSubjectOptimizer) bool {
+	return pr.Repeat > 1 && !opt.CacheEligible()
+}
+
 func toFilesToResults(fileResults []*runner.FileResult) runner.FilesToResults {
 	filesToResults := make(runner.FilesToResults)
 
@@ -251,15 +308,36 @@ func toFilesToResults(fileResults []*runner.FileResult) runner.FilesToResults {
 		if _, ok := filesToResults[result.Filename]; !ok {
 			filesToResults[result.Filename] = make(runner.OptimizersToResults)
 		}
-		filesToResults[result.Filename][result.Executor.Identifier()] = result.Value
+		filesToResults[result.Filename][result.Executor.Identifier()] = runner.OptimizerResult{
+			Value:   result.Value,
+			Timing:  result.Timing,
+			Samples: result.Samples,
+			Outcome: runner.OutcomeOf(result.Result.Err),
+			Err:     result.Result.Err,
+		}
 	}
 
 	return filesToResults
 }
 
+// updateLocalCache stores the result in the cache. In case of repeated runs, the whole distribution
+// of values is stored instead of a single value, so it isn't mistaken for the result of a deterministic optimizer.
 func updateLocalCache(localCache cache.Cache, filename string, update *executor.Result) {
 	fileInfo := localCache.Get(filename)
-	fileInfo.Results[update.Executor.Identifier()] = update.Value
+
+	if len(update.Samples) > 0 {
+		if fileInfo.Samples == nil {
+			fileInfo.Samples = make(cache.OptimizersToSamples)
+		}
+		fileInfo.Samples[update.Executor.Identifier()] = update.Samples
+	} else {
+		fileInfo.Results[update.Executor.Identifier()] = update.Value
+	}
+
+	if fileInfo.Timings == nil {
+		fileInfo.Timings = make(cache.OptimizersToTimings)
+	}
+	fileInfo.Timings[update.Executor.Identifier()] = update.Timing
 }
 
 func mergeFileResults(channels ...<-chan *runner.FileResult) <-chan *runner.FileResult {
@@ -285,14 +363,10 @@ func mergeFileResults(channels ...<-chan *runner.FileResult) <-chan *runner.File
 	return out
 }
 
-func getModelExecutorBuilderWithThreadPool(threads uint) func(string, string) executor.Executor {
-	return func(modelPath string, dataPath string) executor.Executor {
-		return executor.NewCplexWithThreadPool(modelPath, dataPath, threads)
-	}
-}
-
-func buildDirectoryViewWithoutCacheFile(dir string) (view.DirectoryView, error) {
+// buildDirectoryViewWithoutHiddenFiles builds view of data files, i.e. it skips hidden files
+// such as cache.Filename or vehicle IDs of scenarios stored next to data files.
+func buildDirectoryViewWithoutHiddenFiles(dir string) (view.DirectoryView, error) {
 	return view.NewDirectoryWithExclusion(dir, func(filename string) bool {
-		return filename == cache.Filename
+		return strings.HasPrefix(filename, ".")
 	})
 }
@@ -3,9 +3,11 @@ package path
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/cache"
@@ -13,6 +15,8 @@ import (
 	"github.com/lothar1998/v2x-optimizer/internal/performance/optimizer"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner"
 	"github.com/lothar1998/v2x-optimizer/internal/performance/runner/view"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
+	pkgOptimizer "github.com/lothar1998/v2x-optimizer/pkg/optimizer"
 	cacheMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/cache"
 	executorMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/executor"
 	optimizerMock "github.com/lothar1998/v2x-optimizer/test/mocks/performance/optimizer"
@@ -81,7 +85,7 @@ func Test_pathRunner_Run(t *testing.T) {
 
 	expectedFilesToResults := runner.FilesToResults{
 		"f1": runner.OptimizersToResults{
-			"o1": 1,
+			"o1": {Value: 1},
 		},
 	}
 
@@ -203,13 +207,12 @@ func Test_pathRunner_getAllExecutors(t *testing.T) {
 	t.Run("should return all executors", func(t *testing.T) {
 		t.Parallel()
 
-		cplexOptimizerName := "cplex-optimizer"
+		referenceName := "reference-optimizer"
 		optimizerName1 := "optimizer-1"
 		optimizerName2 := "optimizer-2"
-		executorNames := []string{cplexOptimizerName, optimizerName1, optimizerName2}
+		executorNames := []string{referenceName, optimizerName1, optimizerName2}
 
 		expectedDataPath := "/test/dir/data.dat"
-		expectedModelPath := "/test/dir/model/model.opl"
 
 		controller := gomock.NewController(t)
 
@@ -221,11 +224,10 @@ func Test_pathRunner_getAllExecutors(t *testing.T) {
 
 		optimizers := []optimizer.PerformanceSubjectOptimizer{optimizer1, optimizer2}
 
-		cplexExecutorBuildMock := func(modelPath, dataPath string) executor.Executor {
-			assert.Equal(t, expectedModelPath, modelPath)
+		referenceExecutorBuildMock := func(dataPath string) executor.Executor {
 			assert.Equal(t, expectedDataPath, dataPath)
 			e := executorMock.NewMockExecutor(controller)
-			e.EXPECT().Identifier().Return(cplexOptimizerName)
+			e.EXPECT().Identifier().Return(referenceName)
 			return e
 		}
 
@@ -238,8 +240,7 @@ func Test_pathRunner_getAllExecutors(t *testing.T) {
 
 		r := pathRunner{
 			optimizers:                 optimizers,
-			modelPath:                  expectedModelPath,
-			cplexExecutorBuildFunc:     cplexExecutorBuildMock,
+			referenceExecutorBuildFunc: referenceExecutorBuildMock,
 			optimizerExecutorBuildFunc: optimizerExecutorBuildMock,
 		}
 
@@ -256,13 +257,12 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 	t.Parallel()
 
 	expectedDataPath := "/test/dir/data.dat"
-	expectedModelPath := "/test/dir/model/model.opl"
 
-	cplexOptimizerName := "cplex-optimizer"
+	referenceName := "reference-optimizer"
 	optimizerName1 := "optimizer-1"
 	optimizerName2 := "optimizer-2"
 
-	executorNames := []string{cplexOptimizerName, optimizerName1, optimizerName2}
+	executorNames := []string{referenceName, optimizerName1, optimizerName2}
 
 	controller := gomock.NewController(t)
 	optimizer1 := optimizerMock.NewMockPerformanceSubjectOptimizer(controller)
@@ -273,11 +273,10 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 
 	optimizers := []optimizer.PerformanceSubjectOptimizer{optimizer1, optimizer2}
 
-	cplexExecutorBuildMock := func(modelPath string, dataPath string) executor.Executor {
-		assert.Equal(t, expectedModelPath, modelPath)
+	referenceExecutorBuildMock := func(dataPath string) executor.Executor {
 		assert.Equal(t, expectedDataPath, dataPath)
 		e := executorMock.NewMockExecutor(controller)
-		e.EXPECT().Identifier().Return(cplexOptimizerName).AnyTimes()
+		e.EXPECT().Identifier().Return(referenceName).AnyTimes()
 		return e
 	}
 
@@ -290,9 +289,8 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 
 	r := pathRunner{
 		optimizers:                 optimizers,
-		modelPath:                  expectedModelPath,
-		cplexExecutorBuildFunc:     cplexExecutorBuildMock,
-		cplexOptimizerName:         cplexOptimizerName,
+		referenceExecutorBuildFunc: referenceExecutorBuildMock,
+		referenceName:              referenceName,
 		optimizerExecutorBuildFunc: optimizerExecutorBuildMock,
 	}
 
@@ -315,7 +313,7 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 		}
 	})
 
-	t.Run("should return dummy executors for cached ones - cplex executor result not cached", func(t *testing.T) {
+	t.Run("should return dummy executors for cached ones - reference executor result not cached", func(t *testing.T) {
 		t.Parallel()
 
 		fileInfo := &cache.FileInfo{
@@ -334,14 +332,14 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 		}
 	})
 
-	t.Run("should return dummy executors for cached ones - cplex executor result cached", func(t *testing.T) {
+	t.Run("should return dummy executors for cached ones - reference executor result cached", func(t *testing.T) {
 		t.Parallel()
 
 		fileInfo := &cache.FileInfo{
 			Hash: "example_hash",
 			Results: cache.OptimizersToResults{
-				optimizerName2:     3,
-				cplexOptimizerName: 12,
+				optimizerName2: 3,
+				referenceName:  12,
 			},
 		}
 
@@ -350,7 +348,7 @@ func Test_pathRunner_getNotCachedExecutors(t *testing.T) {
 		assert.Len(t, executors, 3)
 		for _, e := range executors {
 			assert.Contains(t, executorNames, e.Identifier())
-			if e.Identifier() == optimizerName2 || e.Identifier() == r.cplexOptimizerName {
+			if e.Identifier() == optimizerName2 || e.Identifier() == r.referenceName {
 				assert.IsType(t, &executor.Dummy{}, e)
 			}
 		}
@@ -372,8 +370,8 @@ func Test_pathRunner_runForDir(t *testing.T) {
 
 		expectedResults := runner.FilesToResults{
 			"my-file": runner.OptimizersToResults{
-				"identifier-1": 11,
-				"identifier-2": 22,
+				"identifier-1": {Value: 11, Timing: timing.Timing{WallTime: time.Second}},
+				"identifier-2": {Value: 22, Timing: timing.Timing{WallTime: time.Minute, CPUTime: time.Hour}},
 			},
 		}
 
@@ -383,6 +381,10 @@ func Test_pathRunner_runForDir(t *testing.T) {
 				"identifier-1": 11,
 				"identifier-2": 22,
 			},
+			Timings: cache.OptimizersToTimings{
+				"identifier-1": timing.Timing{WallTime: time.Second},
+				"identifier-2": timing.Timing{WallTime: time.Minute, CPUTime: time.Hour},
+			},
 		}
 
 		runForFileWithCacheMock := func(_ context.Context, _ cache.Cache, filename string) <-chan *runner.FileResult {
@@ -397,6 +399,7 @@ func Test_pathRunner_runForDir(t *testing.T) {
 				Result: &executor.Result{
 					Executor: executorMock1,
 					Value:    11,
+					Timing:   timing.Timing{WallTime: time.Second},
 				},
 			}
 
@@ -408,6 +411,7 @@ func Test_pathRunner_runForDir(t *testing.T) {
 				Result: &executor.Result{
 					Executor: executorMock2,
 					Value:    22,
+					Timing:   timing.Timing{WallTime: time.Minute, CPUTime: time.Hour},
 				},
 			}
 
@@ -443,8 +447,8 @@ func Test_pathRunner_runForDir(t *testing.T) {
 
 		expectedResults := runner.FilesToResults{
 			"my-file": runner.OptimizersToResults{
-				"identifier-1": 11,
-				"identifier-2": 22,
+				"identifier-1": {Value: 11},
+				"identifier-2": {Value: 22},
 			},
 		}
 
@@ -453,6 +457,9 @@ func Test_pathRunner_runForDir(t *testing.T) {
 			Results: cache.OptimizersToResults{
 				"identifier-1": 11,
 			},
+			Timings: cache.OptimizersToTimings{
+				"identifier-1": timing.Timing{},
+			},
 		}
 
 		runForFileWithCacheMock := func(_ context.Context, _ cache.Cache, filename string) <-chan *runner.FileResult {
@@ -518,6 +525,9 @@ func Test_pathRunner_runForDir(t *testing.T) {
 			Results: cache.OptimizersToResults{
 				"identifier-1": 11,
 			},
+			Timings: cache.OptimizersToTimings{
+				"identifier-1": timing.Timing{},
+			},
 		}
 
 		runForFileWithCacheMock := func(_ context.Context, _ cache.Cache, filename string) <-chan *runner.FileResult {
@@ -572,6 +582,120 @@ func Test_pathRunner_runForDir(t *testing.T) {
 		assert.Equal(t, expectedCache, fileInfo)
 	})
 
+	t.Run("should record failure of executor as outcome if continue on error is set", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := fmt.Errorf("wrapped: %w", pkgOptimizer.ErrCannotAssignToBucket)
+
+		expectedResults := runner.FilesToResults{
+			"my-file": runner.OptimizersToResults{
+				"identifier-1": {Value: 11},
+				"identifier-2": {Outcome: runner.OutcomeInfeasible, Err: expectedError},
+			},
+		}
+
+		expectedCache := &cache.FileInfo{
+			Hash: "h1",
+			Results: cache.OptimizersToResults{
+				"identifier-1": 11,
+			},
+			Timings: cache.OptimizersToTimings{
+				"identifier-1": timing.Timing{},
+			},
+		}
+
+		runForFileWithCacheMock := func(_ context.Context, _ cache.Cache, filename string) <-chan *runner.FileResult {
+			assert.Equal(t, expectedFilename, filename)
+			ch := make(chan *runner.FileResult, 2)
+
+			executorMock1 := executorMock.NewMockExecutor(gomock.NewController(t))
+			executorMock1.EXPECT().Identifier().Return("identifier-1").AnyTimes()
+			executorMock1.EXPECT().CacheEligible().Return(true)
+			ch <- &runner.FileResult{
+				Filename: expectedFilename,
+				Result: &executor.Result{
+					Executor: executorMock1,
+					Value:    11,
+				},
+			}
+
+			executorMock2 := executorMock.NewMockExecutor(gomock.NewController(t))
+			executorMock2.EXPECT().Identifier().Return("identifier-2").AnyTimes()
+			ch <- &runner.FileResult{
+				Filename: expectedFilename,
+				Result: &executor.Result{
+					Executor: executorMock2,
+					Err:      expectedError,
+				},
+			}
+
+			close(ch)
+			return ch
+		}
+
+		fileInfo := &cache.FileInfo{
+			Hash:    "h1",
+			Results: cache.OptimizersToResults{},
+		}
+
+		localCacheMock := cacheMock.NewMockCache(gomock.NewController(t))
+		localCacheMock.EXPECT().Get(expectedFilename).Return(fileInfo)
+		localCacheMock.EXPECT().Save().Return(nil)
+
+		r := pathRunner{
+			cacheLoadFunc: func(dir string) (cache.Cache, error) {
+				assert.Equal(t, expectedDir, dir)
+				return localCacheMock, nil
+			},
+			runForFileWithCacheFunc: runForFileWithCacheMock,
+			Options:                 runner.Options{ContinueOnError: true},
+		}
+
+		results, err := r.runForDir(context.TODO(), v)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResults, results)
+		assert.Equal(t, expectedCache, fileInfo)
+	})
+
+	t.Run("should record timeout of executor as outcome even if continue on error is not set", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResults := runner.FilesToResults{
+			"my-file": runner.OptimizersToResults{
+				"identifier-1": {Outcome: runner.OutcomeTimeout, Err: context.DeadlineExceeded},
+			},
+		}
+
+		runForFileWithCacheMock := func(_ context.Context, _ cache.Cache, filename string) <-chan *runner.FileResult {
+			assert.Equal(t, expectedFilename, filename)
+			ch := make(chan *runner.FileResult, 1)
+
+			executorMock1 := executorMock.NewMockExecutor(gomock.NewController(t))
+			executorMock1.EXPECT().Identifier().Return("identifier-1").AnyTimes()
+			ch <- &runner.FileResult{
+				Filename: expectedFilename,
+				Result: &executor.Result{
+					Executor: executorMock1,
+					Err:      context.DeadlineExceeded,
+				},
+			}
+
+			close(ch)
+			return ch
+		}
+
+		r := pathRunner{
+			cacheLoadFunc: func(dir string) (cache.Cache, error) {
+				return cacheMock.NewMockCache(gomock.NewController(t)), nil
+			},
+			runForFileWithCacheFunc: runForFileWithCacheMock,
+		}
+
+		results, err := r.runForDir(context.TODO(), v)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResults, results)
+	})
+
 	t.Run("should handle error from fileForFileWithCache subroutine", func(t *testing.T) {
 		t.Parallel()
 
@@ -735,7 +859,6 @@ func Test_pathRunner_runForFileWithCache(t *testing.T) {
 	expectedDir := "test-dir"
 	expectedFilename := "my-file"
 	expectedFilepath := filepath.Join(expectedDir, expectedFilename)
-	expectedModelPath := "model-path"
 
 	controller := gomock.NewController(t)
 
@@ -802,9 +925,7 @@ func Test_pathRunner_runForFileWithCache(t *testing.T) {
 		r := pathRunner{
 			FileRunner: fileRunner,
 			optimizers: []optimizer.PerformanceSubjectOptimizer{optimizerMock},
-			modelPath:  expectedModelPath,
-			cplexExecutorBuildFunc: func(modelPath, dataPath string) executor.Executor {
-				assert.Equal(t, expectedModelPath, modelPath)
+			referenceExecutorBuildFunc: func(dataPath string) executor.Executor {
 				assert.Equal(t, expectedFilepath, dataPath)
 				return executorMock1
 			},
@@ -866,9 +987,9 @@ func Test_pathRunner_runForFileWithCache(t *testing.T) {
 		fileRunner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(fileRunnerRunMock)
 
 		r := pathRunner{
-			FileRunner:         fileRunner,
-			optimizers:         []optimizer.PerformanceSubjectOptimizer{optimizerMock},
-			cplexOptimizerName: executorIdentifier1,
+			FileRunner:    fileRunner,
+			optimizers:    []optimizer.PerformanceSubjectOptimizer{optimizerMock},
+			referenceName: executorIdentifier1,
 		}
 
 		results := r.runForFileWithCache(context.TODO(), localCacheMock, expectedFilename)
@@ -917,9 +1038,7 @@ func Test_pathRunner_runForFileWithCache(t *testing.T) {
 		r := pathRunner{
 			FileRunner: fileRunner,
 			optimizers: []optimizer.PerformanceSubjectOptimizer{optimizerMock},
-			modelPath:  expectedModelPath,
-			cplexExecutorBuildFunc: func(modelPath, dataPath string) executor.Executor {
-				assert.Equal(t, expectedModelPath, modelPath)
+			referenceExecutorBuildFunc: func(dataPath string) executor.Executor {
 				assert.Equal(t, expectedFilepath, dataPath)
 				return executorMock1
 			},
@@ -1022,8 +1141,12 @@ func Test_pathRunner_toFilesToResults(t *testing.T) {
 		results := toFilesToResults(fileResults)
 
 		assert.Len(t, results, 2)
-		assert.Equal(t, runner.OptimizersToResults{executorIdentifier1: 11, executorIdentifier2: 12}, results["f1"])
-		assert.Equal(t, runner.OptimizersToResults{executorIdentifier1: 21, executorIdentifier2: 22}, results["f2"])
+		assert.Equal(t,
+			runner.OptimizersToResults{executorIdentifier1: {Value: 11}, executorIdentifier2: {Value: 12}},
+			results["f1"])
+		assert.Equal(t,
+			runner.OptimizersToResults{executorIdentifier1: {Value: 21}, executorIdentifier2: {Value: 22}},
+			results["f2"])
 	})
 }
 
@@ -1036,15 +1159,114 @@ func Test_updateLocalCache(t *testing.T) {
 		filename := "my-file"
 
 		exec := executorMock.NewMockExecutor(gomock.NewController(t))
-		exec.EXPECT().Identifier().Return("exec")
+		exec.EXPECT().Identifier().Return("exec").Times(2)
 
 		localCache := cache.NewEmptyCache("my-dir")
-		localCache.Put(filename, &cache.FileInfo{Results: cache.OptimizersToResults(make(runner.OptimizersToResults))})
+		localCache.Put(filename, &cache.FileInfo{Results: make(cache.OptimizersToResults)})
 
-		updateLocalCache(localCache, filename, &executor.Result{Executor: exec, Value: 1})
+		updateLocalCache(localCache, filename, &executor.Result{
+			Executor: exec,
+			Value:    1,
+			Timing:   timing.Timing{WallTime: time.Second},
+		})
 
 		fileInfo := localCache.Get(filename)
 
 		assert.Equal(t, 1, fileInfo.Results["exec"])
+		assert.Equal(t, timing.Timing{WallTime: time.Second}, fileInfo.Timings["exec"])
+	})
+
+	t.Run("should store samples instead of value in case of repeated runs", func(t *testing.T) {
+		t.Parallel()
+
+		filename := "my-file"
+
+		exec := executorMock.NewMockExecutor(gomock.NewController(t))
+		exec.EXPECT().Identifier().Return("exec").Times(2)
+
+		localCache := cache.NewEmptyCache("my-dir")
+		localCache.Put(filename, &cache.FileInfo{Results: make(cache.OptimizersToResults)})
+
+		updateLocalCache(localCache, filename, &executor.Result{
+			Executor: exec,
+			Value:    3,
+			Timing:   timing.Timing{WallTime: time.Second},
+			Samples:  []int{3, 2, 4},
+		})
+
+		fileInfo := localCache.Get(filename)
+
+		assert.NotContains(t, fileInfo.Results, "exec")
+		assert.Equal(t, []int{3, 2, 4}, fileInfo.Samples["exec"])
+		assert.Equal(t, timing.Timing{WallTime: time.Second}, fileInfo.Timings["exec"])
+	})
+}
+
+func Test_pathRunner_repeat(t *testing.T) {
+	t.Parallel()
+
+	dataPath := "/test/dir/data.dat"
+
+	deterministicName := "deterministic"
+	randomizedName := "randomized"
+
+	controller := gomock.NewController(t)
+	deterministic := optimizerMock.NewMockPerformanceSubjectOptimizer(controller)
+	randomized := optimizerMock.NewMockPerformanceSubjectOptimizer(controller)
+
+	deterministic.EXPECT().Identifier().Return(deterministicName).AnyTimes()
+	deterministic.EXPECT().CacheEligible().Return(true).AnyTimes()
+	randomized.EXPECT().Identifier().Return(randomizedName).AnyTimes()
+	randomized.EXPECT().CacheEligible().Return(false).AnyTimes()
+
+	r := pathRunner{
+		optimizers: []optimizer.PerformanceSubjectOptimizer{deterministic, randomized},
+		referenceExecutorBuildFunc: func(string) executor.Executor {
+			return &executor.Dummy{Name: "reference"}
+		},
+		referenceName: "reference",
+		optimizerExecutorBuildFunc: func(_ string, o optimizer.PerformanceSubjectOptimizer) executor.Executor {
+			return executor.NewCustom(dataPath, o)
+		},
+		Options: runner.Options{Repeat: 3},
+	}
+
+	t.Run("should repeat only non-deterministic optimizers", func(t *testing.T) {
+		t.Parallel()
+
+		executors := r.getAllExecutors(dataPath)
+
+		assert.Len(t, executors, 3)
+		assert.IsType(t, &executor.Custom{}, executors[1])
+		assert.IsType(t, &executor.Repeated{}, executors[2])
+		assert.Equal(t, 3, executors[2].(*executor.Repeated).Count)
+	})
+
+	t.Run("should use cached samples if there are enough of them", func(t *testing.T) {
+		t.Parallel()
+
+		fileInfo := &cache.FileInfo{
+			Results: cache.OptimizersToResults{"reference": 2, deterministicName: 3},
+			Samples: cache.OptimizersToSamples{randomizedName: {4, 3, 5, 4}},
+		}
+
+		executors := r.getNotCachedExecutors(dataPath, fileInfo)
+
+		assert.Len(t, executors, 3)
+		assert.Equal(t, &executor.Dummy{Name: randomizedName, Result: 4, Samples: []int{4, 3, 5}}, executors[2])
+	})
+
+	t.Run("should repeat runs if there are not enough cached samples", func(t *testing.T) {
+		t.Parallel()
+
+		fileInfo := &cache.FileInfo{
+			Results: cache.OptimizersToResults{"reference": 2, deterministicName: 3},
+			Samples: cache.OptimizersToSamples{randomizedName: {4, 3}},
+		}
+
+		executors := r.getNotCachedExecutors(dataPath, fileInfo)
+
+		assert.Len(t, executors, 3)
+		assert.IsType(t, &executor.Repeated{}, executors[2])
 	})
 }
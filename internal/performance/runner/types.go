@@ -2,8 +2,10 @@ package runner
 
 import (
 	"context"
+	"time"
 
 	"github.com/lothar1998/v2x-optimizer/internal/performance/executor"
+	"github.com/lothar1998/v2x-optimizer/internal/performance/timing"
 )
 
 type FileRunner interface {
@@ -30,4 +32,31 @@ type PathsToResults map[string]FilesToResults
 
 type FilesToResults map[string]OptimizersToResults
 
-type OptimizersToResults map[string]int
+type OptimizersToResults map[string]OptimizerResult
+
+// OptimizerResult represents the value obtained by the optimizer for a single file
+// along with the time consumed to obtain it. If the optimizer failed, Outcome describes the kind
+// of failure and Err holds the cause, while Value and Timing are meaningless.
+// If the optimizer has been run repeatedly, Samples holds values of all runs and Value is the value of the first one.
+type OptimizerResult struct {
+	Value int
+	timing.Timing
+	Samples []int
+	Outcome Outcome
+	Err     error
+}
+
+// Options represents the behavior of runners.
+type Options struct {
+	// ContinueOnError makes runners record failures of executors as outcomes of particular files
+	// instead of discarding results of the whole path.
+	ContinueOnError bool
+	// TimeoutPerRun limits the time of each execution of an executor. Zero means no limit.
+	// Executions that exceed the limit are recorded as timeouts regardless of ContinueOnError.
+	TimeoutPerRun time.Duration
+	// ValidateResults makes executors verify feasibility of obtained results. Infeasible results are treated as errors.
+	ValidateResults bool
+	// Repeat defines how many times non-deterministic optimizers, i.e. the ones that are not cache eligible,
+	// are run for each file. Values lower than 2 mean a single run.
+	Repeat int
+}
@@ -0,0 +1,54 @@
+package timing
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Timing represents time consumed by a single execution.
+// CPUTime is equal to zero if it cannot be measured, e.g. for optimizers running inside the current process.
+type Timing struct {
+	WallTime time.Duration `json:"wall_time"`
+	CPUTime  time.Duration `json:"cpu_time,omitempty"`
+}
+
+// Summary represents statistics computed over a set of durations.
+type Summary struct {
+	Average time.Duration
+	Median  time.Duration
+	P95     time.Duration
+}
+
+// Summarize computes Summary of given durations. Median and 95th percentile are computed
+// using the nearest-rank method. It returns zero Summary for an empty set of durations.
+func Summarize(durations []time.Duration) Summary {
+	if len(durations) == 0 {
+		return Summary{}
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	return Summary{
+		Average: total / time.Duration(len(sorted)),
+		Median:  percentile(sorted, 50),
+		P95:     percentile(sorted, 95),
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,42 @@
+package timing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should compute average, median and 95th percentile", func(t *testing.T) {
+		t.Parallel()
+
+		durations := make([]time.Duration, 20)
+		for i := range durations {
+			durations[len(durations)-1-i] = time.Duration(i+1) * time.Millisecond
+		}
+
+		summary := Summarize(durations)
+
+		assert.Equal(t, 10500*time.Microsecond, summary.Average)
+		assert.Equal(t, 10*time.Millisecond, summary.Median)
+		assert.Equal(t, 19*time.Millisecond, summary.P95)
+		assert.Equal(t, 20*time.Millisecond, durations[0])
+	})
+
+	t.Run("should handle single duration", func(t *testing.T) {
+		t.Parallel()
+
+		summary := Summarize([]time.Duration{time.Second})
+
+		assert.Equal(t, Summary{time.Second, time.Second, time.Second}, summary)
+	})
+
+	t.Run("should return zero summary for no durations", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Zero(t, Summarize(nil))
+	})
+}
@@ -0,0 +1,169 @@
+// Package solution parses solutions of the binary program exported by encoder.LP and encoder.MPS
+// that are written by external MIP solvers.
+package solution
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+const (
+	bucketVariablePrefix     = "x_"
+	assignmentVariablePrefix = "y_"
+	dualSectionPrefix        = "# dual"
+	infeasibleStatus         = "infeasible"
+)
+
+// nonOptimalStatuses are the parts of statuses reported by solvers that stop before proving optimality,
+// e.g. "Stopped on time" of CBC or "Time limit reached" of HiGHS and SCIP.
+var nonOptimalStatuses = []string{"stopped", "limit", "interrupt", "not optimal"}
+
+var (
+	// ErrInfeasible is returned if the solver reports that there is no feasible solution.
+	// It wraps optimizer.ErrCannotAssignToBucket, so it is treated the same way as infeasibility of optimizers.
+	ErrInfeasible = fmt.Errorf("%w: solver reported infeasible problem", optimizer.ErrCannotAssignToBucket)
+	// ErrNotOptimal is returned if the solver reports that it stopped before proving optimality of the solution,
+	// so the solution cannot be used as the reference value.
+	ErrNotOptimal = errors.New("solver reported non-optimal solution")
+	// ErrIncompleteSolution is returned if some item is not assigned to exactly one bucket.
+	ErrIncompleteSolution = errors.New("incomplete solution")
+)
+
+// FromSol parses solution in the .sol format written by most MIP solvers, e.g. CBC, HiGHS, SCIP or Gurobi.
+// These formats differ in headers, but all of them list variables by lines that contain the name of a variable
+// followed by its value, and most of them omit variables equal to zero. Thus, FromSol looks for assignment
+// variables y_v_n in each line and assumes that variables which are not listed are equal to zero.
+// Lines starting with '#' are comments and dual values that some solvers append after primal values are ignored.
+// The result is computed from the assignment only, so buckets enabled without any assigned item are not counted.
+// It returns ErrInfeasible if the header, i.e. lines preceding variables, reports infeasibility,
+// ErrNotOptimal if the header reports that the solver stopped before proving optimality
+// and ErrIncompleteSolution if some item is not assigned to exactly one bucket.
+// Solvers that don't report any status in the header, e.g. Gurobi, should be run without limits,
+// since their solutions are assumed to be optimal.
+func FromSol(content string, vehicleCount, rrhCount int) (*optimizer.Result, error) {
+	assignment := make([]int, vehicleCount)
+	for i := range assignment {
+		assignment[i] = -1
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	isHeader := true
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		lowerCaseLine := strings.ToLower(line)
+
+		if strings.HasPrefix(lowerCaseLine, dualSectionPrefix) {
+			break
+		}
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		isHeader = isHeader && !containsVariable(line)
+
+		if isHeader && strings.Contains(lowerCaseLine, infeasibleStatus) {
+			return nil, ErrInfeasible
+		}
+
+		if isHeader && isNonOptimalStatus(lowerCaseLine) {
+			return nil, fmt.Errorf("%w: %s", ErrNotOptimal, line)
+		}
+
+		if err := parseLine(line, assignment, rrhCount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for v, n := range assignment {
+		if n == -1 {
+			return nil, fmt.Errorf("%w: vehicle %d is not assigned to any RRH", ErrIncompleteSolution, v)
+		}
+	}
+
+	return helper.ToResult(assignment, rrhCount), nil
+}
+
+func parseLine(line string, assignment []int, rrhCount int) error {
+	fields := strings.Fields(line)
+
+	for i := 0; i < len(fields)-1; i++ {
+		v, n, ok := parseAssignmentVariable(fields[i])
+		if !ok || v >= len(assignment) || n >= rrhCount {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(fields[i+1], 64)
+		if err != nil {
+			return fmt.Errorf("cannot parse value of %s: %w", fields[i], err)
+		}
+
+		// solvers may report binary values with some tolerance
+		if value < 0.5 {
+			return nil
+		}
+
+		if assignment[v] != -1 && assignment[v] != n {
+			return fmt.Errorf("%w: vehicle %d is assigned to several RRHs", ErrIncompleteSolution, v)
+		}
+
+		assignment[v] = n
+
+		return nil
+	}
+
+	return nil
+}
+
+func isNonOptimalStatus(lowerCaseLine string) bool {
+	for _, status := range nonOptimalStatuses {
+		if strings.Contains(lowerCaseLine, status) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVariable(line string) bool {
+	for _, field := range strings.Fields(line) {
+		if strings.HasPrefix(field, bucketVariablePrefix) || strings.HasPrefix(field, assignmentVariablePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAssignmentVariable parses the indexes of the item and the bucket from the name of assignment variable.
+func parseAssignmentVariable(name string) (v, n int, ok bool) {
+	if !strings.HasPrefix(name, assignmentVariablePrefix) {
+		return 0, 0, false
+	}
+
+	indexes := strings.Split(strings.TrimPrefix(name, assignmentVariablePrefix), "_")
+	if len(indexes) != 2 {
+		return 0, 0, false
+	}
+
+	v, err := strconv.Atoi(indexes[0])
+	if err != nil || v < 0 {
+		return 0, 0, false
+	}
+
+	n, err = strconv.Atoi(indexes[1])
+	if err != nil || n < 0 {
+		return 0, 0, false
+	}
+
+	return v, n, true
+}
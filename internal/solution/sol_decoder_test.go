@@ -0,0 +1,186 @@
+package solution
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSol(t *testing.T) {
+	t.Parallel()
+
+	expectedResult := &optimizer.Result{
+		RRHCount:                1,
+		RRHEnable:               []bool{false, true},
+		VehiclesToRRHAssignment: []int{1, 1},
+	}
+
+	t.Run("should parse CBC solution", func(t *testing.T) {
+		t.Parallel()
+
+		content := "Optimal - objective value 1.00000000\n" +
+			"      1 x_1                       1                       1\n" +
+			"      3 y_0_1                     1                       0\n" +
+			"      5 y_1_1                     1                       0\n"
+
+		result, err := FromSol(content, 2, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResult, result)
+	})
+
+	t.Run("should parse HiGHS solution and ignore dual values", func(t *testing.T) {
+		t.Parallel()
+
+		content := "Model status\n" +
+			"Optimal\n" +
+			"\n" +
+			"# Primal solution values\n" +
+			"Feasible\n" +
+			"Objective 1\n" +
+			"# Columns 6\n" +
+			"x_0 0\n" +
+			"x_1 1\n" +
+			"y_0_0 0\n" +
+			"y_0_1 1\n" +
+			"y_1_0 -0\n" +
+			"y_1_1 0.9999999\n" +
+			"# Rows 2\n" +
+			"assignment_0 1\n" +
+			"assignment_1 1\n" +
+			"\n" +
+			"# Dual solution values\n" +
+			"Feasible\n" +
+			"# Columns 6\n" +
+			"y_1_0 1\n"
+
+		result, err := FromSol(content, 2, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResult, result)
+	})
+
+	t.Run("should parse SCIP solution", func(t *testing.T) {
+		t.Parallel()
+
+		content := "solution status: optimal solution found\n" +
+			"objective value:                                    1\n" +
+			"x_1                                                 1 \t(obj:1)\n" +
+			"y_0_1                                               1 \t(obj:0)\n" +
+			"y_1_1                                               1 \t(obj:0)\n"
+
+		result, err := FromSol(content, 2, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResult, result)
+	})
+
+	t.Run("should parse Gurobi solution", func(t *testing.T) {
+		t.Parallel()
+
+		content := "# Solution for model v2x\n" +
+			"# Objective value = 1\n" +
+			"x_0 0\n" +
+			"x_1 1\n" +
+			"y_0_0 0\n" +
+			"y_0_1 1\n" +
+			"y_1_0 0\n" +
+			"y_1_1 1\n"
+
+		result, err := FromSol(content, 2, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResult, result)
+	})
+
+	t.Run("should return error if solver reports infeasible problem", func(t *testing.T) {
+		t.Parallel()
+
+		content := "Infeasible - objective value 0.00000000\n" +
+			"      3 y_0_1                     1                       0\n"
+
+		result, err := FromSol(content, 2, 2)
+
+		assert.ErrorIs(t, err, ErrInfeasible)
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if solver reports non-optimal solution", func(t *testing.T) {
+		t.Parallel()
+
+		contents := map[string]string{
+			"CBC": "Stopped on time - objective value 1.00000000\n" +
+				"      3 y_0_1                     1                       0\n" +
+				"      5 y_1_1                     1                       0\n",
+			"HiGHS": "Model status\n" +
+				"Time limit reached\n" +
+				"\n" +
+				"# Primal solution values\n" +
+				"Feasible\n" +
+				"y_0_1 1\n" +
+				"y_1_1 1\n",
+			"SCIP": "solution status: time limit reached\n" +
+				"y_0_1                                               1 \t(obj:0)\n" +
+				"y_1_1                                               1 \t(obj:0)\n",
+		}
+
+		for solver, content := range contents {
+			result, err := FromSol(content, 2, 2)
+
+			assert.ErrorIs(t, err, ErrNotOptimal, solver)
+			assert.Nil(t, result, solver)
+		}
+	})
+
+	t.Run("should return error if some vehicle is not assigned", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := FromSol("y_0_1 1\n", 2, 2)
+
+		assert.ErrorIs(t, err, ErrIncompleteSolution)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if some vehicle is assigned to several RRHs", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := FromSol("y_0_0 1\ny_0_1 1\ny_1_1 1\n", 2, 2)
+
+		assert.ErrorIs(t, err, ErrIncompleteSolution)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if value cannot be parsed", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := FromSol("y_0_0 one\ny_1_1 1\n", 2, 2)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func Test_parseAssignmentVariable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should parse indexes of assignment variable", func(t *testing.T) {
+		t.Parallel()
+
+		v, n, ok := parseAssignmentVariable("y_12_3")
+
+		assert.True(t, ok)
+		assert.Equal(t, 12, v)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("should reject other names", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{"x_1", "y_1", "y_1_2_3", "y_a_1", "y_-1_1", "assignment_0"} {
+			_, _, ok := parseAssignmentVariable(name)
+			assert.False(t, ok, name)
+		}
+	})
+}
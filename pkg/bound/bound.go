@@ -0,0 +1,68 @@
+// Package bound provides lower bounds on the number of RRHs (RRHCount) required to serve all vehicles.
+// Bounds allow for assessing the quality of solutions of instances for which the optimal value is not known.
+package bound
+
+import "github.com/lothar1998/v2x-optimizer/pkg/data"
+
+// LowerBound is a function that returns the value that is not greater than RRHCount of any feasible solution.
+type LowerBound func(data *data.Data) int
+
+// Best returns the greatest of Volume, L2 and LPRelaxation bounds.
+func Best(data *data.Data) int {
+	best := 0
+	for _, lowerBound := range []LowerBound{Volume, L2, LPRelaxation} {
+		if value := lowerBound(data); value > best {
+			best = value
+		}
+	}
+	return best
+}
+
+// minSizes returns the smallest size of each item among buckets it fits into. If the item doesn't fit
+// into any bucket, its smallest size among all buckets is returned.
+func minSizes(data *data.Data) []int {
+	sizes := make([]int, len(data.R))
+
+	for item, itemSizes := range data.R {
+		fitting, overall := -1, -1
+
+		for bucket, size := range itemSizes {
+			if overall < 0 || size < overall {
+				overall = size
+			}
+
+			if size <= data.MRB[bucket] && (fitting < 0 || size < fitting) {
+				fitting = size
+			}
+		}
+
+		sizes[item] = fitting
+		if fitting < 0 {
+			sizes[item] = overall
+		}
+
+		if sizes[item] < 0 {
+			sizes[item] = 0
+		}
+	}
+
+	return sizes
+}
+
+func maxBucketSize(data *data.Data) int {
+	var result int
+	for _, size := range data.MRB {
+		if size > result {
+			result = size
+		}
+	}
+	return result
+}
+
+// atLeastOne returns the value increased to one if there is any item, since any item requires a bucket.
+func atLeastOne(data *data.Data, value int) int {
+	if len(data.R) > 0 && value < 1 {
+		return 1
+	}
+	return value
+}
@@ -0,0 +1,120 @@
+package bound
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/branchandbound"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLowerBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		data         *data.Data
+		volume       int
+		l2           int
+		lpRelaxation int
+		best         int
+	}{
+		{
+			"should return zero for no items",
+			&data.Data{MRB: []int{10, 10}},
+			0, 0, 0, 0,
+		},
+		{
+			"should return one for items of zero size",
+			&data.Data{MRB: []int{10, 10}, R: [][]int{{0, 0}, {0, 0}}},
+			1, 1, 1, 1,
+		},
+		{
+			"should return optimal value for items of equal sizes in all buckets",
+			&data.Data{
+				MRB: []int{10, 10, 10},
+				R:   [][]int{{6, 6, 6}, {2, 2, 2}, {3, 3, 3}, {5, 5, 5}, {4, 4, 4}},
+			},
+			2, 2, 2, 2,
+		},
+		{
+			"should take into account that big items cannot share buckets",
+			&data.Data{
+				MRB: []int{10, 10, 10},
+				R:   [][]int{{6, 6, 6}, {6, 6, 6}, {6, 6, 6}},
+			},
+			2, 3, 2, 3,
+		},
+		{
+			"should take into account that sizes of items depend on buckets",
+			&data.Data{
+				MRB: []int{10, 20},
+				R:   [][]int{{10, 20}, {10, 20}},
+			},
+			1, 1, 2, 2,
+		},
+		{
+			"should take into account sizes of buckets in linear relaxation",
+			&data.Data{
+				MRB: []int{4, 20},
+				R:   [][]int{{2, 20}, {2, 20}, {2, 20}},
+			},
+			1, 1, 2, 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.volume, Volume(tt.data), "volume")
+			assert.Equal(t, tt.l2, L2(tt.data), "l2")
+			assert.Equal(t, tt.lpRelaxation, LPRelaxation(tt.data), "lp relaxation")
+			assert.Equal(t, tt.best, Best(tt.data), "best")
+		})
+	}
+}
+
+func TestLowerBounds_Validity(t *testing.T) {
+	t.Parallel()
+
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 30; i++ {
+		d := randomData(random, 10, 4, 12, 30)
+
+		result, err := branchandbound.BranchAndBound{}.Optimize(context.TODO(), d)
+		if err != nil {
+			continue
+		}
+
+		for name, lowerBound := range map[string]LowerBound{
+			"volume":        Volume,
+			"l2":            L2,
+			"lp relaxation": LPRelaxation,
+			"best":          Best,
+		} {
+			assert.LessOrEqual(t, lowerBound(d), result.RRHCount, "%s bound of instance %d", name, i)
+		}
+	}
+}
+
+func randomData(random *rand.Rand, v, n, maxItemSize, maxBucketSize int) *data.Data {
+	d := &data.Data{MRB: make([]int, n), R: make([][]int, v)}
+
+	for j := range d.MRB {
+		d.MRB[j] = random.Intn(maxBucketSize) + 1
+	}
+
+	for i := range d.R {
+		d.R[i] = make([]int, n)
+		for j := range d.R[i] {
+			d.R[i][j] = random.Intn(maxItemSize) + 1
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,67 @@
+package bound
+
+import (
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// L2 returns the bound of Martello and Toth for the classic bin packing problem computed for the relaxed instance,
+// in which all buckets have the size of the biggest MRB and each item has its smallest size among buckets
+// it fits into. Any feasible solution is also feasible for the relaxed instance, so the bound remains valid.
+// Contrary to the volume bound, it takes into account that big items cannot share buckets.
+// The implementation works in O(v^2) time.
+func L2(data *data.Data) int {
+	sizes := minSizes(data)
+	capacity := maxBucketSize(data)
+
+	best := 0
+	for _, alpha := range candidateAlphas(sizes, capacity) {
+		if value := l2ForAlpha(sizes, capacity, alpha); value > best {
+			best = value
+		}
+	}
+
+	return atLeastOne(data, best)
+}
+
+// candidateAlphas returns values of the parameter for which the bound may change, i.e. zero and sizes of items
+// not greater than the half of the capacity.
+func candidateAlphas(sizes []int, capacity int) []int {
+	alphas := []int{0}
+	for _, size := range sizes {
+		if 2*size <= capacity {
+			alphas = append(alphas, size)
+		}
+	}
+	return alphas
+}
+
+// l2ForAlpha counts items bigger than capacity-alpha and bigger than the half of the capacity, which
+// need separate buckets, and adds the number of buckets required by items not smaller than alpha that don't fit
+// into the space left by items bigger than the half of the capacity.
+func l2ForAlpha(sizes []int, capacity, alpha int) int {
+	var large, medium, mediumVolume, smallVolume int
+
+	for _, size := range sizes {
+		switch {
+		case size > capacity-alpha:
+			large++
+		case 2*size > capacity:
+			medium++
+			mediumVolume += size
+		case size >= alpha:
+			smallVolume += size
+		}
+	}
+
+	bound := large + medium
+
+	if capacity == 0 {
+		return bound
+	}
+
+	if excess := smallVolume - (medium*capacity - mediumVolume); excess > 0 {
+		bound += (excess + capacity - 1) / capacity
+	}
+
+	return bound
+}
@@ -0,0 +1,108 @@
+package bound
+
+import (
+	"math"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+const (
+	lpIterations = 300
+	// lpPatience is the number of iterations without improvement after which the step size is halved.
+	lpPatience = 20
+	// lpTolerance protects against rounding up the bound due to floating-point errors.
+	lpTolerance = 1e-6
+)
+
+// LPRelaxation returns the bound given by the linear relaxation of the model, in which RRHEnable
+// of each bucket may be fractional and is equal to its load divided by its MRB, and each item may be split
+// among buckets it fits into. The relaxation is solved approximately using the Lagrangian relaxation
+// of MRB constraints with multipliers found by the subgradient method. The Lagrangian function gives
+// a valid bound for any non-negative multipliers, so the bound is valid regardless of the number of iterations,
+// and tends to the optimal value of the linear relaxation.
+func LPRelaxation(data *data.Data) int {
+	n := len(data.MRB)
+
+	multipliers := make([]float64, n)
+	subgradient := make([]float64, n)
+
+	best := 0.0
+	step := 2.0
+	sinceImprovement := 0
+
+	for iteration := 0; iteration < lpIterations; iteration++ {
+		value := lagrangian(data, multipliers, subgradient)
+
+		if value > best+lpTolerance {
+			best = value
+			sinceImprovement = 0
+		} else if sinceImprovement++; sinceImprovement >= lpPatience {
+			step /= 2
+			sinceImprovement = 0
+		}
+
+		var norm float64
+		for bucket, g := range subgradient {
+			if g < 0 && multipliers[bucket] == 0 {
+				subgradient[bucket] = 0
+				continue
+			}
+			norm += g * g
+		}
+
+		if norm == 0 {
+			break
+		}
+
+		// The number of buckets is the trivial upper bound of the optimal value.
+		stepSize := step * (float64(n) - value) / norm
+
+		for bucket, g := range subgradient {
+			multipliers[bucket] = math.Max(0, multipliers[bucket]+stepSize*g)
+		}
+	}
+
+	return atLeastOne(data, int(math.Ceil(best-lpTolerance)))
+}
+
+// lagrangian returns the value of the Lagrangian function for the given multipliers of MRB constraints and fills
+// the subgradient with the violation of MRB constraints by the assignment minimizing the function.
+func lagrangian(data *data.Data, multipliers, subgradient []float64) float64 {
+	var value float64
+
+	for bucket, size := range data.MRB {
+		value -= multipliers[bucket] * float64(size)
+		subgradient[bucket] = -float64(size)
+	}
+
+	for _, sizes := range data.R {
+		bestBucket := -1
+		bestCost := math.Inf(1)
+
+		for bucket, size := range sizes {
+			if size > data.MRB[bucket] {
+				continue
+			}
+
+			var cost float64
+			if size > 0 {
+				cost = float64(size) * (1/float64(data.MRB[bucket]) + multipliers[bucket])
+			}
+
+			if cost < bestCost {
+				bestBucket = bucket
+				bestCost = cost
+			}
+		}
+
+		// items that don't fit into any bucket are skipped, since the instance is infeasible anyway
+		if bestBucket < 0 {
+			continue
+		}
+
+		value += bestCost
+		subgradient[bestBucket] += float64(sizes[bestBucket])
+	}
+
+	return value
+}
@@ -0,0 +1,32 @@
+package bound
+
+import (
+	"sort"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// Volume returns the bound based on the total volume of items. The volume of each item is its smallest size
+// among buckets it fits into. The bound is the smallest number of the biggest buckets whose total size
+// is not less than the volume of all items, so it is at least as tight as the volume divided by the biggest MRB.
+func Volume(data *data.Data) int {
+	var volume int
+	for _, size := range minSizes(data) {
+		volume += size
+	}
+
+	bucketSizes := make([]int, len(data.MRB))
+	copy(bucketSizes, data.MRB)
+	sort.Sort(sort.Reverse(sort.IntSlice(bucketSizes)))
+
+	count, capacity := 0, 0
+	for _, size := range bucketSizes {
+		if capacity >= volume {
+			break
+		}
+		capacity += size
+		count++
+	}
+
+	return atLeastOne(data, count)
+}
@@ -83,7 +83,7 @@ func (e CPLEX) Decode(reader io.Reader) (*data.Data, error) {
 
 		case strings.HasPrefix(line, "R"):
 			arrays := parseArrayOfArrays(line)
-			var r [][]int
+			r := [][]int{}
 			for _, array := range arrays {
 				intArray, err := parseIntArray(array)
 				if err != nil {
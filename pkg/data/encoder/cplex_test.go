@@ -36,6 +36,23 @@ func TestCPLEXEncoder_Encode_Decode_Compatibility(t *testing.T) {
 	assert.Equal(t, expectedData, decodedData)
 }
 
+func TestCPLEXEncoder_Encode_ignoresMetadata(t *testing.T) {
+	t.Parallel()
+
+	withoutMetadata := &data.Data{MRB: []int{1, 2}, R: [][]int{{3, 4}}}
+	withMetadata := &data.Data{
+		MRB:      withoutMetadata.MRB,
+		R:        withoutMetadata.R,
+		Metadata: &data.Metadata{Geometry: &data.Geometry{Stations: []data.Point{{X: 0.1, Y: 0.2}}}},
+	}
+
+	var expected, actual bytes.Buffer
+
+	assert.NoError(t, CPLEX{}.Encode(withoutMetadata, &expected))
+	assert.NoError(t, CPLEX{}.Encode(withMetadata, &actual))
+	assert.Equal(t, expected.String(), actual.String())
+}
+
 func TestCPLEXEncoder_Decode(t *testing.T) {
 	t.Parallel()
 
@@ -96,6 +113,21 @@ func TestCPLEXEncoder_Decode(t *testing.T) {
 		assert.Equal(t, expectedData, decodeData)
 	})
 
+	t.Run("should decode data without vehicles", func(t *testing.T) {
+		t.Parallel()
+
+		cplexStr := "V = 0;\n" +
+			"N = 2;\n" +
+			"MRB = [1 2];\n" +
+			"R = [\n" +
+			"];\n"
+
+		decodeData, err := CPLEX{}.Decode(strings.NewReader(cplexStr))
+
+		assert.NoError(t, err)
+		assert.Equal(t, &data.Data{MRB: []int{1, 2}, R: [][]int{}}, decodeData)
+	})
+
 	t.Run("should not decode incorrect data", func(t *testing.T) {
 		t.Parallel()
 
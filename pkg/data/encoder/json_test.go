@@ -71,6 +71,32 @@ func TestEncode(t *testing.T) {
 	assert.Equal(t, trimWhiteSigns(jsonString), trimWhiteSigns(buffer.String()))
 }
 
+func TestJSON_metadata(t *testing.T) {
+	t.Parallel()
+
+	expectedData := &data.Data{
+		MRB: []int{1, 2},
+		R:   [][]int{{3, 4}},
+		Metadata: &data.Metadata{
+			Geometry: &data.Geometry{
+				Vehicles: []data.Point{{X: 0.5, Y: 0.25}},
+				Stations: []data.Point{{X: 0.1, Y: 0.2}, {X: 0.3, Y: 0.4}},
+			},
+			Parameters: []byte(`{"seed":7}`),
+		},
+	}
+
+	var buffer bytes.Buffer
+
+	err := JSON{}.Encode(expectedData, &buffer)
+	assert.NoError(t, err)
+
+	decodedData, err := JSON{}.Decode(&buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedData, decodedData)
+}
+
 func trimWhiteSigns(str string) string {
 	spaceTrimmed := strings.ReplaceAll(str, " ", "")
 	newLineTrimmed := strings.ReplaceAll(spaceTrimmed, "\n", "")
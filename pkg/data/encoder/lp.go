@@ -0,0 +1,103 @@
+package encoder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// lpTermsPerLine limits the number of terms in a line, since LP readers may limit the length of lines.
+const lpTermsPerLine = 8
+
+var lpSenses = map[sense]string{
+	lessOrEqual:    "<=",
+	equal:          "=",
+	greaterOrEqual: ">=",
+}
+
+// LP facilitates encoding Data to CPLEX LP format. The encoded data is the whole binary program defined
+// by v2x-optimization.mod, so it can be solved by any MIP solver without OPL. Variables x_n enable buckets
+// and variables y_v_n assign items to buckets, where v and n are zero-based indexes. For example:
+//
+//	\ V = 1, N = 2
+//	Minimize
+//	 obj: x_0 + x_1
+//	Subject To
+//	 capacity_0: 3 y_0_0 <= 5
+//	 capacity_1: 4 y_0_1 <= 6
+//	 assignment_0: y_0_0 + y_0_1 = 1
+//	 enable_0_0: x_0 - y_0_0 >= 0
+//	 enable_0_1: x_1 - y_0_1 >= 0
+//	Binary
+//	 x_0
+//	 x_1
+//	 y_0_0
+//	 y_0_1
+//	End
+//
+// LP is an export-only format, thus there is no way to decode Data from it.
+type LP struct{}
+
+// Encode allows for encoding Data to CPLEX LP format.
+// It returns ErrMalformedData if the lengths of R slices are not equal to MRB slice length
+// or if there are items but no buckets.
+func (e LP) Encode(input *data.Data, writer io.Writer) error {
+	program, err := newBinaryProgram(input)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(writer)
+
+	_, _ = fmt.Fprintf(w, "\\ V = %d, N = %d\n", len(input.R), len(input.MRB))
+
+	_, _ = w.WriteString("Minimize\n obj:")
+	writeLPTerms(w, program.objective)
+	_, _ = w.WriteString("\nSubject To\n")
+
+	for _, c := range program.constraints {
+		_, _ = w.WriteString(" " + c.name + ":")
+		writeLPTerms(w, c.terms)
+		_, _ = fmt.Fprintf(w, " %s %d\n", lpSenses[c.sense], c.rhs)
+	}
+
+	_, _ = w.WriteString("Binary\n")
+
+	for _, variable := range program.variables {
+		_, _ = w.WriteString(" " + variable + "\n")
+	}
+
+	_, _ = w.WriteString("End\n")
+
+	// bufio.Writer keeps the first write error and returns it on Flush
+	return w.Flush()
+}
+
+func writeLPTerms(w *bufio.Writer, terms []term) {
+	for i, t := range terms {
+		if i > 0 && i%lpTermsPerLine == 0 {
+			_, _ = w.WriteString("\n  ")
+		}
+
+		_, _ = w.WriteString(formatLPTerm(t, i == 0))
+	}
+}
+
+func formatLPTerm(t term, isFirst bool) string {
+	sign, coefficient := "+ ", t.coefficient
+	if coefficient < 0 {
+		sign, coefficient = "- ", -coefficient
+	}
+
+	if isFirst && coefficient == t.coefficient {
+		sign = ""
+	}
+
+	if coefficient == 1 {
+		return " " + sign + t.variable
+	}
+
+	return fmt.Sprintf(" %s%d %s", sign, coefficient, t.variable)
+}
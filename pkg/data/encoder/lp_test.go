@@ -0,0 +1,112 @@
+package encoder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLPEncoder_Encode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should encode data to LP format", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: []int{5, 6}, R: [][]int{{3, 4}, {0, 2}}}
+
+		expectedEncodedString := "\\ V = 2, N = 2\n" +
+			"Minimize\n" +
+			" obj: x_0 + x_1\n" +
+			"Subject To\n" +
+			" capacity_0: 3 y_0_0 <= 5\n" +
+			" capacity_1: 4 y_0_1 + 2 y_1_1 <= 6\n" +
+			" assignment_0: y_0_0 + y_0_1 = 1\n" +
+			" assignment_1: y_1_0 + y_1_1 = 1\n" +
+			" enable_0_0: x_0 - y_0_0 >= 0\n" +
+			" enable_0_1: x_1 - y_0_1 >= 0\n" +
+			" enable_1_0: x_0 - y_1_0 >= 0\n" +
+			" enable_1_1: x_1 - y_1_1 >= 0\n" +
+			"Binary\n" +
+			" x_0\n" +
+			" x_1\n" +
+			" y_0_0\n" +
+			" y_0_1\n" +
+			" y_1_0\n" +
+			" y_1_1\n" +
+			"End\n"
+
+		var buffer bytes.Buffer
+
+		err := LP{}.Encode(input, &buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedEncodedString, buffer.String())
+	})
+
+	t.Run("should break long expressions into several lines", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: make([]int, lpTermsPerLine+1)}
+
+		var buffer bytes.Buffer
+
+		err := LP{}.Encode(input, &buffer)
+
+		assert.NoError(t, err)
+		assert.Contains(t, buffer.String(), " obj: x_0 + x_1 + x_2 + x_3 + x_4 + x_5 + x_6 + x_7\n   + x_8\n")
+	})
+
+	t.Run("should encode data without items and buckets", func(t *testing.T) {
+		t.Parallel()
+
+		var buffer bytes.Buffer
+
+		err := LP{}.Encode(&data.Data{}, &buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "\\ V = 0, N = 0\nMinimize\n obj:\nSubject To\nBinary\nEnd\n", buffer.String())
+	})
+
+	t.Run("should return error if lengths of R slices differ from MRB length", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: []int{5, 6}, R: [][]int{{3, 4}, {2}}}
+
+		err := LP{}.Encode(input, &strings.Builder{})
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+	})
+
+	t.Run("should return error if there are items but no buckets", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: []int{}, R: [][]int{{}}}
+
+		err := LP{}.Encode(input, &strings.Builder{})
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+	})
+}
+
+func Test_formatLPTerm(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should omit unit coefficients", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, " x_0", formatLPTerm(term{1, "x_0"}, true))
+		assert.Equal(t, " + x_0", formatLPTerm(term{1, "x_0"}, false))
+		assert.Equal(t, " - x_0", formatLPTerm(term{-1, "x_0"}, false))
+	})
+
+	t.Run("should keep sign of negative first term", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, " - 3 y_0_0", formatLPTerm(term{-3, "y_0_0"}, true))
+		assert.Equal(t, " 3 y_0_0", formatLPTerm(term{3, "y_0_0"}, true))
+		assert.Equal(t, " + 3 y_0_0", formatLPTerm(term{3, "y_0_0"}, false))
+	})
+}
@@ -0,0 +1,93 @@
+package encoder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+const mpsObjectiveRow = "obj"
+
+var mpsSenses = map[sense]string{
+	lessOrEqual:    "L",
+	equal:          "E",
+	greaterOrEqual: "G",
+}
+
+// MPS facilitates encoding Data to free MPS format. The encoded data is the whole binary program defined
+// by v2x-optimization.mod, so it can be solved by any MIP solver without OPL. Variables are named the same way
+// as in LP format and are declared binary using BV bounds. Zero right-hand sides are omitted as they are
+// the default ones. MPS is an export-only format, thus there is no way to decode Data from it.
+type MPS struct{}
+
+// Encode allows for encoding Data to free MPS format.
+// It returns ErrMalformedData if the lengths of R slices are not equal to MRB slice length
+// or if there are items but no buckets.
+func (e MPS) Encode(input *data.Data, writer io.Writer) error {
+	program, err := newBinaryProgram(input)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(writer)
+
+	_, _ = w.WriteString("NAME v2x\nROWS\n")
+	_, _ = w.WriteString(" N " + mpsObjectiveRow + "\n")
+
+	for _, c := range program.constraints {
+		_, _ = w.WriteString(" " + mpsSenses[c.sense] + " " + c.name + "\n")
+	}
+
+	_, _ = w.WriteString("COLUMNS\n")
+
+	columns := toColumns(program)
+	for _, variable := range program.variables {
+		for _, entry := range columns[variable] {
+			_, _ = fmt.Fprintf(w, " %s %s %d\n", variable, entry.row, entry.coefficient)
+		}
+	}
+
+	_, _ = w.WriteString("RHS\n")
+
+	for _, c := range program.constraints {
+		if c.rhs != 0 {
+			_, _ = fmt.Fprintf(w, " rhs %s %d\n", c.name, c.rhs)
+		}
+	}
+
+	_, _ = w.WriteString("BOUNDS\n")
+
+	for _, variable := range program.variables {
+		_, _ = w.WriteString(" BV bnd " + variable + "\n")
+	}
+
+	_, _ = w.WriteString("ENDATA\n")
+
+	// bufio.Writer keeps the first write error and returns it on Flush
+	return w.Flush()
+}
+
+// columnEntry is a nonzero coefficient of a variable in a row.
+type columnEntry struct {
+	row         string
+	coefficient int
+}
+
+// toColumns transposes the program, since MPS format lists coefficients column by column.
+func toColumns(program *binaryProgram) map[string][]columnEntry {
+	columns := make(map[string][]columnEntry, len(program.variables))
+
+	for _, t := range program.objective {
+		columns[t.variable] = append(columns[t.variable], columnEntry{mpsObjectiveRow, t.coefficient})
+	}
+
+	for _, c := range program.constraints {
+		for _, t := range c.terms {
+			columns[t.variable] = append(columns[t.variable], columnEntry{c.name, t.coefficient})
+		}
+	}
+
+	return columns
+}
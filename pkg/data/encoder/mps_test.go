@@ -0,0 +1,80 @@
+package encoder
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMPSEncoder_Encode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should encode data to free MPS format", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: []int{5, 6}, R: [][]int{{3, 4}, {0, 2}}}
+
+		expectedEncodedString := "NAME v2x\n" +
+			"ROWS\n" +
+			" N obj\n" +
+			" L capacity_0\n" +
+			" L capacity_1\n" +
+			" E assignment_0\n" +
+			" E assignment_1\n" +
+			" G enable_0_0\n" +
+			" G enable_0_1\n" +
+			" G enable_1_0\n" +
+			" G enable_1_1\n" +
+			"COLUMNS\n" +
+			" x_0 obj 1\n" +
+			" x_0 enable_0_0 1\n" +
+			" x_0 enable_1_0 1\n" +
+			" x_1 obj 1\n" +
+			" x_1 enable_0_1 1\n" +
+			" x_1 enable_1_1 1\n" +
+			" y_0_0 capacity_0 3\n" +
+			" y_0_0 assignment_0 1\n" +
+			" y_0_0 enable_0_0 -1\n" +
+			" y_0_1 capacity_1 4\n" +
+			" y_0_1 assignment_0 1\n" +
+			" y_0_1 enable_0_1 -1\n" +
+			" y_1_0 assignment_1 1\n" +
+			" y_1_0 enable_1_0 -1\n" +
+			" y_1_1 capacity_1 2\n" +
+			" y_1_1 assignment_1 1\n" +
+			" y_1_1 enable_1_1 -1\n" +
+			"RHS\n" +
+			" rhs capacity_0 5\n" +
+			" rhs capacity_1 6\n" +
+			" rhs assignment_0 1\n" +
+			" rhs assignment_1 1\n" +
+			"BOUNDS\n" +
+			" BV bnd x_0\n" +
+			" BV bnd x_1\n" +
+			" BV bnd y_0_0\n" +
+			" BV bnd y_0_1\n" +
+			" BV bnd y_1_0\n" +
+			" BV bnd y_1_1\n" +
+			"ENDATA\n"
+
+		var buffer bytes.Buffer
+
+		err := MPS{}.Encode(input, &buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedEncodedString, buffer.String())
+	})
+
+	t.Run("should return error if lengths of R slices differ from MRB length", func(t *testing.T) {
+		t.Parallel()
+
+		input := &data.Data{MRB: []int{5, 6}, R: [][]int{{3}}}
+
+		err := MPS{}.Encode(input, &strings.Builder{})
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+	})
+}
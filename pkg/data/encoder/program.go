@@ -0,0 +1,113 @@
+package encoder
+
+import (
+	"fmt"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// sense is the relation between the left-hand side and the right-hand side of a constraint.
+type sense int
+
+const (
+	lessOrEqual sense = iota
+	equal
+	greaterOrEqual
+)
+
+// term is a variable multiplied by a coefficient.
+type term struct {
+	coefficient int
+	variable    string
+}
+
+// constraint is a linear constraint of the form: sum of terms, sense, rhs.
+type constraint struct {
+	name  string
+	terms []term
+	sense sense
+	rhs   int
+}
+
+// binaryProgram is the binary program defined by v2x-optimization.mod in the form of linear expressions.
+// Variables x_n enable buckets and variables y_v_n assign items to buckets, where v and n are zero-based indexes.
+// The program minimizes the number of enabled buckets subject to capacity constraints of buckets,
+// assignment of every item to exactly one bucket and enabling buckets to which items are assigned.
+type binaryProgram struct {
+	objective   []term
+	constraints []constraint
+	variables   []string
+}
+
+// newBinaryProgram returns binaryProgram of the given data. Capacity constraints without any nonzero term
+// are always satisfied, thus they are omitted. It returns data.ErrMalformedData if the lengths of R slices
+// are not equal to MRB slice length or if there are items but no buckets, since an assignment constraint
+// without any term cannot be expressed.
+func newBinaryProgram(input *data.Data) (*binaryProgram, error) {
+	bucketCount := len(input.MRB)
+
+	if len(input.R) > 0 && bucketCount == 0 {
+		return nil, data.ErrMalformedData
+	}
+
+	for _, sizes := range input.R {
+		if len(sizes) != bucketCount {
+			return nil, data.ErrMalformedData
+		}
+	}
+
+	var program binaryProgram
+
+	for n := range input.MRB {
+		program.objective = append(program.objective, term{1, bucketVariable(n)})
+		program.variables = append(program.variables, bucketVariable(n))
+	}
+
+	for v := range input.R {
+		for n := range input.MRB {
+			program.variables = append(program.variables, assignmentVariable(v, n))
+		}
+	}
+
+	for n, capacity := range input.MRB {
+		var terms []term
+		for v := range input.R {
+			if input.R[v][n] != 0 {
+				terms = append(terms, term{input.R[v][n], assignmentVariable(v, n)})
+			}
+		}
+
+		if len(terms) > 0 {
+			name := fmt.Sprintf("capacity_%d", n)
+			program.constraints = append(program.constraints, constraint{name, terms, lessOrEqual, capacity})
+		}
+	}
+
+	for v := range input.R {
+		terms := make([]term, bucketCount)
+		for n := range input.MRB {
+			terms[n] = term{1, assignmentVariable(v, n)}
+		}
+
+		name := fmt.Sprintf("assignment_%d", v)
+		program.constraints = append(program.constraints, constraint{name, terms, equal, 1})
+	}
+
+	for v := range input.R {
+		for n := range input.MRB {
+			name := fmt.Sprintf("enable_%d_%d", v, n)
+			terms := []term{{1, bucketVariable(n)}, {-1, assignmentVariable(v, n)}}
+			program.constraints = append(program.constraints, constraint{name, terms, greaterOrEqual, 0})
+		}
+	}
+
+	return &program, nil
+}
+
+func bucketVariable(n int) string {
+	return fmt.Sprintf("x_%d", n)
+}
+
+func assignmentVariable(v, n int) string {
+	return fmt.Sprintf("y_%d_%d", v, n)
+}
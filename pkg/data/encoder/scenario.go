@@ -0,0 +1,229 @@
+package encoder
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+const (
+	// ScenarioSnapshotFilePattern is a pattern of names of snapshot files written by ScenarioDirectory.
+	ScenarioSnapshotFilePattern = "snapshot_%d.v2x"
+	// ScenarioVehicleIDsFilename is a name of the file with vehicle IDs written by ScenarioDirectory.
+	// It is hidden, so the directory can still be used as a directory of data files.
+	ScenarioVehicleIDsFilename = ".vehicle_ids"
+	// ScenarioSnapshotMetadataFilePattern is a pattern of names of hidden files with metadata of snapshots
+	// written by ScenarioDirectory, since CPLEX format doesn't store metadata.
+	ScenarioSnapshotMetadataFilePattern = ".snapshot_%d.meta.json"
+	// ScenarioParametersFilename is a name of the hidden file with parameters of the scenario
+	// written by ScenarioDirectory.
+	ScenarioParametersFilename = ".parameters.json"
+)
+
+// ScenarioJSON provides methods for encoding and decoding data.Scenario into/from json.
+type ScenarioJSON struct{}
+
+// Encode facilitates encoding data.Scenario to json.
+// It returns an error wrapping ErrMalformedData if the scenario is not valid.
+func (e ScenarioJSON) Encode(input *data.Scenario, w io.Writer) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	return json.NewEncoder(w).Encode(input)
+}
+
+// Decode allows for decoding data.Scenario from json. It returns an error wrapping ErrMalformedData
+// if the json is malformed or the decoded scenario is not valid.
+func (e ScenarioJSON) Decode(r io.Reader) (*data.Scenario, error) {
+	var output data.Scenario
+
+	if err := json.NewDecoder(r).Decode(&output); err != nil {
+		return nil, data.ErrMalformedData
+	}
+
+	if err := output.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// ScenarioDirectory facilitates encoding data.Scenario to a directory. Each snapshot is written in CPLEX format
+// to a separate file named according to ScenarioSnapshotFilePattern, so it can be optimized on its own
+// like any other data file. Vehicle IDs of consecutive snapshots are written to ScenarioVehicleIDsFilename
+// as consecutive lines in CSV-like format. Metadata of snapshots, if any, are written in json
+// to separate files named according to ScenarioSnapshotMetadataFilePattern and parameters of the scenario,
+// if any, are written to ScenarioParametersFilename.
+type ScenarioDirectory struct{}
+
+// Encode writes data.Scenario to the given directory, which is created if it doesn't exist.
+// It returns an error wrapping ErrMalformedData if the scenario is not valid.
+func (e ScenarioDirectory) Encode(input *data.Scenario, dir string) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0775); err != nil {
+		return err
+	}
+
+	if len(input.Parameters) > 0 {
+		err := ioutil.WriteFile(filepath.Join(dir, ScenarioParametersFilename), input.Parameters, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	idsFile, err := os.Create(filepath.Join(dir, ScenarioVehicleIDsFilename))
+	if err != nil {
+		return err
+	}
+	defer idsFile.Close()
+
+	for i := range input.Snapshots {
+		snapshot := &input.Snapshots[i]
+
+		if err := writeSnapshot(&snapshot.Data, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, i))); err != nil {
+			return err
+		}
+
+		if _, err := idsFile.WriteString(joinInts(snapshot.VehicleIDs, DefaultDelimiter) + "\n"); err != nil {
+			return err
+		}
+
+		if snapshot.Metadata == nil {
+			continue
+		}
+
+		metadataPath := filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, i))
+		if err := writeSnapshotMetadata(snapshot.Metadata, metadataPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads data.Scenario from the given directory. The number of snapshots is defined
+// by the number of lines in ScenarioVehicleIDsFilename. Metadata of snapshots and parameters of the scenario
+// are read if their files exist.
+// It returns an error wrapping ErrMalformedData if some file is malformed or the decoded scenario is not valid.
+func (e ScenarioDirectory) Decode(dir string) (*data.Scenario, error) {
+	idsFile, err := os.Open(filepath.Join(dir, ScenarioVehicleIDsFilename))
+	if err != nil {
+		return nil, err
+	}
+	defer idsFile.Close()
+
+	var output data.Scenario
+
+	output.Parameters, err = readParameters(filepath.Join(dir, ScenarioParametersFilename))
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(idsFile)
+	for i := 0; scanner.Scan(); i++ {
+		ids, err := splitIntString(scanner.Text(), DefaultDelimiter)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotData, err := readSnapshot(filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, i)))
+		if err != nil {
+			return nil, err
+		}
+
+		metadataPath := filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, i))
+		snapshotData.Metadata, err = readSnapshotMetadata(metadataPath)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Snapshots = append(output.Snapshots, data.Snapshot{Data: *snapshotData, VehicleIDs: ids})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := output.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func writeSnapshot(snapshot *data.Data, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return CPLEX{}.Encode(snapshot, file)
+}
+
+func readSnapshot(path string) (*data.Data, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return CPLEX{}.Decode(file)
+}
+
+func writeSnapshotMetadata(metadata *data.Metadata, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(metadata)
+}
+
+// readSnapshotMetadata returns nil metadata if the file doesn't exist.
+func readSnapshotMetadata(path string) (*data.Metadata, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var metadata data.Metadata
+	if err := json.NewDecoder(file).Decode(&metadata); err != nil {
+		return nil, data.ErrMalformedData
+	}
+
+	return &metadata, nil
+}
+
+// readParameters returns nil parameters if the file doesn't exist.
+func readParameters(path string) (json.RawMessage, error) {
+	parameters, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !json.Valid(parameters) {
+		return nil, data.ErrMalformedData
+	}
+
+	return parameters, nil
+}
@@ -0,0 +1,241 @@
+package encoder
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func testScenario() *data.Scenario {
+	return &data.Scenario{Snapshots: []data.Snapshot{
+		{Data: data.Data{MRB: []int{5, 6}, R: [][]int{{1, 2}, {3, 4}}}, VehicleIDs: []int{3, 1}},
+		{Data: data.Data{MRB: []int{5, 6}, R: [][]int{{2, 2}}}, VehicleIDs: []int{1}},
+		{Data: data.Data{MRB: []int{5, 6}, R: [][]int{{2, 3}, {5, 5}}}, VehicleIDs: []int{1, 2}},
+	}}
+}
+
+func testScenarioWithMetadata() *data.Scenario {
+	s := testScenario()
+	s.Snapshots[1].Metadata = &data.Metadata{Geometry: &data.Geometry{
+		Vehicles: []data.Point{{X: 0.25, Y: 0.5}},
+		Stations: []data.Point{{X: 0.1, Y: 0.2}, {X: 0.9, Y: 0.8}},
+	}}
+	s.Parameters = []byte(`{"seed":1}`)
+	return s
+}
+
+func TestScenarioJSON_Encode_Decode_Compatibility(t *testing.T) {
+	t.Parallel()
+
+	expectedScenario := testScenarioWithMetadata()
+
+	var buffer bytes.Buffer
+
+	err := ScenarioJSON{}.Encode(expectedScenario, &buffer)
+	assert.NoError(t, err)
+
+	decodedScenario, err := ScenarioJSON{}.Decode(&buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedScenario, decodedScenario)
+}
+
+func TestScenarioJSON_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should decode scenario from json", func(t *testing.T) {
+		t.Parallel()
+
+		jsonString := `{"Snapshots": [
+			{"MRB": [5, 6], "R": [[1, 2]], "VehicleIDs": [4]},
+			{"MRB": [5, 6], "R": [], "VehicleIDs": []}
+		]}`
+
+		expectedScenario := &data.Scenario{Snapshots: []data.Snapshot{
+			{Data: data.Data{MRB: []int{5, 6}, R: [][]int{{1, 2}}}, VehicleIDs: []int{4}},
+			{Data: data.Data{MRB: []int{5, 6}, R: [][]int{}}, VehicleIDs: []int{}},
+		}}
+
+		decodedScenario, err := ScenarioJSON{}.Decode(strings.NewReader(jsonString))
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedScenario, decodedScenario)
+	})
+
+	t.Run("should return error if json is malformed", func(t *testing.T) {
+		t.Parallel()
+
+		decodedScenario, err := ScenarioJSON{}.Decode(strings.NewReader(`{"Snapshots": [`))
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.Nil(t, decodedScenario)
+	})
+
+	t.Run("should return error if vehicle IDs are missing", func(t *testing.T) {
+		t.Parallel()
+
+		jsonString := `{"Snapshots": [{"MRB": [5, 6], "R": [[1, 2]]}]}`
+
+		decodedScenario, err := ScenarioJSON{}.Decode(strings.NewReader(jsonString))
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.Nil(t, decodedScenario)
+	})
+}
+
+func TestScenarioDirectory_Encode_Decode_Compatibility(t *testing.T) {
+	t.Parallel()
+
+	expectedScenario := testScenarioWithMetadata()
+	dir := filepath.Join(t.TempDir(), "scenario")
+
+	err := ScenarioDirectory{}.Encode(expectedScenario, dir)
+	assert.NoError(t, err)
+
+	decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedScenario, decodedScenario)
+}
+
+func TestScenarioDirectory_Encode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write snapshots in CPLEX format and vehicle IDs in hidden file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		err := ScenarioDirectory{}.Encode(testScenario(), dir)
+		assert.NoError(t, err)
+
+		ids, err := ioutil.ReadFile(filepath.Join(dir, ScenarioVehicleIDsFilename))
+		assert.NoError(t, err)
+		assert.Equal(t, "3,1\n1\n1,2\n", string(ids))
+
+		snapshot, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 1)))
+		assert.NoError(t, err)
+		assert.Equal(t, "V = 1;\nN = 2;\nMRB = [5 6];\nR = [\n[2 2]\n];\n", string(snapshot))
+	})
+
+	t.Run("should write metadata of snapshots in hidden files", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		err := ScenarioDirectory{}.Encode(testScenarioWithMetadata(), dir)
+		assert.NoError(t, err)
+
+		assert.NoFileExists(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, 0)))
+		assert.FileExists(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, 1)))
+		assert.NoFileExists(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, 2)))
+
+		parameters, err := ioutil.ReadFile(filepath.Join(dir, ScenarioParametersFilename))
+		assert.NoError(t, err)
+		assert.Equal(t, `{"seed":1}`, string(parameters))
+	})
+
+	t.Run("should return error if scenario is not valid", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join(t.TempDir(), "scenario")
+		s := &data.Scenario{Snapshots: []data.Snapshot{{Data: data.Data{MRB: []int{1}, R: [][]int{{1}}}}}}
+
+		err := ScenarioDirectory{}.Encode(s, dir)
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.NoDirExists(t, dir)
+	})
+}
+
+func TestScenarioDirectory_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should decode snapshots without vehicles", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ScenarioVehicleIDsFilename), "\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 0)),
+			"V = 0;\nN = 2;\nMRB = [5 6];\nR = [\n];\n")
+
+		decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, decodedScenario.Snapshots, 1)
+		assert.Empty(t, decodedScenario.Snapshots[0].R)
+		assert.Empty(t, decodedScenario.Snapshots[0].VehicleIDs)
+	})
+
+	t.Run("should return error if snapshot file is missing", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ScenarioVehicleIDsFilename), "1\n2\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 0)),
+			"V = 1;\nN = 1;\nMRB = [5];\nR = [\n[2]\n];\n")
+
+		decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		assert.Nil(t, decodedScenario)
+	})
+
+	t.Run("should return error if metadata of snapshot is malformed", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ScenarioVehicleIDsFilename), "1\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 0)),
+			"V = 1;\nN = 1;\nMRB = [5];\nR = [\n[2]\n];\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotMetadataFilePattern, 0)), `{"geometry": `)
+
+		decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.Nil(t, decodedScenario)
+	})
+
+	t.Run("should return error if parameters are malformed", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ScenarioVehicleIDsFilename), "1\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 0)),
+			"V = 1;\nN = 1;\nMRB = [5];\nR = [\n[2]\n];\n")
+		writeFile(t, filepath.Join(dir, ScenarioParametersFilename), `{"seed": `)
+
+		decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.Nil(t, decodedScenario)
+	})
+
+	t.Run("should return error if vehicle IDs don't match snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, ScenarioVehicleIDsFilename), "1,2\n")
+		writeFile(t, filepath.Join(dir, fmt.Sprintf(ScenarioSnapshotFilePattern, 0)),
+			"V = 1;\nN = 1;\nMRB = [5];\nR = [\n[2]\n];\n")
+
+		decodedScenario, err := ScenarioDirectory{}.Decode(dir)
+
+		assert.ErrorIs(t, err, data.ErrMalformedData)
+		assert.Nil(t, decodedScenario)
+	})
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	err := ioutil.WriteFile(path, []byte(content), 0644)
+	assert.NoError(t, err)
+}
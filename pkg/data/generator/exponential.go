@@ -6,25 +6,37 @@ import (
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
 )
 
+// GenerateExponential generates data using the default Generator seeded with the current time.
 func GenerateExponential(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeExponential)
-	bucketSizes := generateBucketsWithSizes(bucketCount, maxBucketSize)
+	return defaultGenerator.GenerateExponential(itemCount, maxItemSize, bucketCount, maxBucketSize)
+}
+
+// GenerateExponentialConstantBucketSize generates data using the default Generator seeded with the current time.
+func GenerateExponentialConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	return defaultGenerator.GenerateExponentialConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize)
+}
+
+func (g *Generator) GenerateExponential(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeExponential)
+	bucketSizes := g.generateBucketsWithSizes(bucketCount, maxBucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func GenerateExponentialConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeExponential)
+func (g *Generator) GenerateExponentialConstantBucketSize(
+	itemCount, maxItemSize, bucketCount, bucketSize int,
+) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeExponential)
 	bucketSizes := generateBucketsOfConstantSize(bucketCount, bucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func generateItemSizeExponential(maxItemSize int) int {
+func (g *Generator) generateItemSizeExponential(maxItemSize int) int {
 	mean := float64(maxItemSize) / 4
 	for {
-		random.ExpFloat64()
-		expValue := random.ExpFloat64() * mean
+		g.random.ExpFloat64()
+		expValue := g.random.ExpFloat64() * mean
 		flooredValue := math.Ceil(expValue)
 		itemSize := int(flooredValue)
 
@@ -5,7 +5,24 @@ import (
 	"time"
 )
 
-var random = rand.New(rand.NewSource(time.Now().UnixNano()))
+var defaultGenerator = New(rand.NewSource(time.Now().UnixNano()))
+
+// Generator generates data using its own source of randomness. Generators created with sources
+// of the same seed generate the same data for the same sequence of calls.
+// Generator is not safe for concurrent use.
+type Generator struct {
+	random *rand.Rand
+}
+
+// New returns Generator that draws random values from the given source.
+func New(source rand.Source) *Generator {
+	return &Generator{random: rand.New(source)}
+}
+
+// NewWithSeed returns Generator that draws random values from the source with the given seed.
+func NewWithSeed(seed int64) *Generator {
+	return New(rand.NewSource(seed))
+}
 
 type generateItemSizeFunc func(int) int
 
@@ -30,10 +47,10 @@ func generateBucketsOfConstantSize(bucketCount, bucketSize int) []int {
 	return bucketSizes
 }
 
-func generateBucketsWithSizes(bucketCount, maxBucketSize int) []int {
+func (g *Generator) generateBucketsWithSizes(bucketCount, maxBucketSize int) []int {
 	bucketSizes := make([]int, bucketCount)
 	for i := range bucketSizes {
-		bucketSizes[i] = random.Intn(maxBucketSize) + 1
+		bucketSizes[i] = g.random.Intn(maxBucketSize) + 1
 	}
 	return bucketSizes
 }
@@ -63,3 +63,35 @@ func verifyGenerateConstantCapacity(t *testing.T, generate generateFunc) {
 		assert.Equal(t, result.MRB[i], bucketSize)
 	}
 }
+
+func TestGenerator_Seed(t *testing.T) {
+	t.Parallel()
+
+	generateFuncs := map[string]func(*Generator) generateFunc{
+		"uniform":     func(g *Generator) generateFunc { return g.GenerateUniform },
+		"exponential": func(g *Generator) generateFunc { return g.GenerateExponential },
+		"normal":      func(g *Generator) generateFunc { return g.GenerateNormal },
+		"v2x":         func(g *Generator) generateFunc { return g.GenerateV2XEnvironmental },
+	}
+
+	for name, toGenerateFunc := range generateFuncs {
+		toGenerateFunc := toGenerateFunc
+		t.Run("should generate the same "+name+" data for the same seed", func(t *testing.T) {
+			t.Parallel()
+
+			first := toGenerateFunc(NewWithSeed(7))(10, 30, 5, 100)
+			second := toGenerateFunc(NewWithSeed(7))(10, 30, 5, 100)
+
+			assert.Equal(t, first, second)
+		})
+
+		t.Run("should generate different "+name+" data for different seeds", func(t *testing.T) {
+			t.Parallel()
+
+			first := toGenerateFunc(NewWithSeed(7))(10, 30, 5, 100)
+			second := toGenerateFunc(NewWithSeed(8))(10, 30, 5, 100)
+
+			assert.NotEqual(t, first, second)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package generator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidLayout is returned if StationLayout cannot be used to place stations.
+var ErrInvalidLayout = errors.New("invalid station layout")
+
+// LayoutKind defines how stations are placed within the square.
+type LayoutKind int
+
+const (
+	// SquareGrid places stations in centers of randomly chosen cells of the smallest square grid
+	// that has enough cells.
+	SquareGrid LayoutKind = iota
+	// HexagonalGrid places stations in centers of randomly chosen cells of the smallest hexagonal grid
+	// that has enough cells within the square.
+	HexagonalGrid
+	// PoissonPointProcess places stations uniformly and independently within the square,
+	// i.e. according to Poisson point process conditioned on the number of stations.
+	PoissonPointProcess
+	// Roadside places stations evenly along the road on alternate sides of it.
+	Roadside
+	// Explicit places stations at the given points.
+	Explicit
+)
+
+// roadsideOffset is the distance between the road and stations placed by Roadside layout.
+const roadsideOffset = 0.01
+
+// Station is a station at the given point with a bucket of the given size.
+type Station struct {
+	Point
+	MRB int
+}
+
+// StationLayout defines placement of stations. Road is a polyline used only by Roadside layout. If it is empty,
+// the road crossing the middle of the square horizontally, i.e. the one of Highway, is used. Stations are used
+// only by Explicit layout and they define the number of buckets and their sizes instead of parameters of configs.
+type StationLayout struct {
+	Kind     LayoutKind
+	Road     []Point
+	Stations []Station
+}
+
+// Validate returns ErrInvalidLayout if stations cannot be placed according to the layout.
+func (l StationLayout) Validate() error {
+	switch l.Kind {
+	case SquareGrid, HexagonalGrid, PoissonPointProcess:
+		return nil
+	case Roadside:
+		if len(l.Road) > 0 && polylineLength(l.Road) <= 0 {
+			return fmt.Errorf("%w: road has to consist of at least two distinct points", ErrInvalidLayout)
+		}
+		return nil
+	case Explicit:
+		return validateStations(l.Stations)
+	default:
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidLayout, l.Kind)
+	}
+}
+
+// generateBuckets returns sizes of buckets of stations placed according to the layout. Sizes are drawn
+// from range [1, bucketSize] if they are neither constant nor given by the layout.
+func (g *Generator) generateBuckets(layout StationLayout, bucketCount, bucketSize int, isConstant bool) []int {
+	switch {
+	case layout.Kind == Explicit:
+		bucketSizes := make([]int, len(layout.Stations))
+		for i, station := range layout.Stations {
+			bucketSizes[i] = station.MRB
+		}
+		return bucketSizes
+	case isConstant:
+		return generateBucketsOfConstantSize(bucketCount, bucketSize)
+	default:
+		return g.generateBucketsWithSizes(bucketCount, bucketSize)
+	}
+}
+
+// placeStations returns points of n stations placed according to the layout.
+func (g *Generator) placeStations(layout StationLayout, n int) []Point {
+	switch layout.Kind {
+	case HexagonalGrid:
+		return g.generateHexagonalStationPoints(n)
+	case PoissonPointProcess:
+		stationPoints := make([]Point, n)
+		for i := range stationPoints {
+			stationPoints[i] = g.randomPoint()
+		}
+		return stationPoints
+	case Roadside:
+		road := layout.Road
+		if len(road) == 0 {
+			road = []Point{{X: 0, Y: squareSideLength / 2.0}, {X: squareSideLength, Y: squareSideLength / 2.0}}
+		}
+		return generateRoadsideStationPoints(road, n)
+	case Explicit:
+		stationPoints := make([]Point, len(layout.Stations))
+		for i, station := range layout.Stations {
+			stationPoints[i] = station.Point
+		}
+		return stationPoints
+	default:
+		return g.generateStationPoints(n)
+	}
+}
+
+// generateHexagonalStationPoints chooses n random sites of the smallest hexagonal lattice, whose rows
+// are horizontal and alternately shifted by half of the distance between sites, with enough sites
+// within the square. The lattice is centered within the square.
+func (g *Generator) generateHexagonalStationPoints(n int) []Point {
+	columnCount, rowCount := 1, 1
+	for ; ; columnCount++ {
+		rowCount = int(math.Floor(2 * float64(columnCount) / math.Sqrt(3)))
+		if columnCount*rowCount >= n {
+			break
+		}
+	}
+
+	spacing := squareSideLength / float64(columnCount)
+	rowSpacing := spacing * math.Sqrt(3) / 2
+	margin := (squareSideLength - float64(rowCount)*rowSpacing) / 2
+
+	sites := g.random.Perm(columnCount * rowCount)[0:n]
+
+	stationPoints := make([]Point, len(sites))
+	for i, site := range sites {
+		row, column := site/columnCount, site%columnCount
+		x := (float64(column) + 0.25 + 0.5*float64(row%2)) * spacing
+		y := margin + (float64(row)+0.5)*rowSpacing
+		stationPoints[i] = Point{X: x, Y: y}
+	}
+
+	return stationPoints
+}
+
+// generateRoadsideStationPoints places n stations evenly along the road, each of them in the middle
+// of its section of the road. Consecutive stations are placed on opposite sides of the road.
+func generateRoadsideStationPoints(road []Point, n int) []Point {
+	length := polylineLength(road)
+
+	stationPoints := make([]Point, n)
+	for i := range stationPoints {
+		position, direction := pointOnPolyline(road, (float64(i)+0.5)*length/float64(n))
+
+		side := 1.0
+		if i%2 == 1 {
+			side = -1
+		}
+
+		stationPoints[i] = clampToSquare(Point{
+			X: position.X - side*direction.Y*roadsideOffset,
+			Y: position.Y + side*direction.X*roadsideOffset,
+		})
+	}
+
+	return stationPoints
+}
+
+func polylineLength(polyline []Point) float64 {
+	var length float64
+	for i := 1; i < len(polyline); i++ {
+		length += polyline[i-1].Distance(polyline[i])
+	}
+	return length
+}
+
+// pointOnPolyline returns the point at the given distance along the polyline
+// and the unit vector of the direction of the polyline at that point.
+func pointOnPolyline(polyline []Point, distance float64) (Point, Point) {
+	var position, direction Point
+
+	for i := 1; i < len(polyline); i++ {
+		segmentLength := polyline[i-1].Distance(polyline[i])
+		if segmentLength == 0 {
+			continue
+		}
+
+		position = polyline[i-1].towards(polyline[i], math.Min(distance/segmentLength, 1))
+		direction = Point{
+			X: (polyline[i].X - polyline[i-1].X) / segmentLength,
+			Y: (polyline[i].Y - polyline[i-1].Y) / segmentLength,
+		}
+
+		if distance <= segmentLength {
+			break
+		}
+		distance -= segmentLength
+	}
+
+	return position, direction
+}
+
+func clampToSquare(p Point) Point {
+	return Point{
+		X: math.Min(math.Max(p.X, 0), squareSideLength),
+		Y: math.Min(math.Max(p.Y, 0), squareSideLength),
+	}
+}
+
+// ReadStationsCSV reads stations from CSV lines in form of "x,y,mrb", where coordinates are relative
+// to the square, whose side is equal to 1. Empty lines and lines starting with # are skipped.
+func ReadStationsCSV(reader io.Reader) ([]Station, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+
+	var stations []Station
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var station Station
+
+		station.X, err = strconv.ParseFloat(strings.TrimSpace(record[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse x coordinate: %w", err)
+		}
+
+		station.Y, err = strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse y coordinate: %w", err)
+		}
+
+		station.MRB, err = strconv.Atoi(strings.TrimSpace(record[2]))
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse MRB: %w", err)
+		}
+
+		stations = append(stations, station)
+	}
+
+	return stations, validateStations(stations)
+}
+
+// ReadStationsJSON reads stations from JSON array of objects with x, y and mrb fields, where coordinates
+// are relative to the square, whose side is equal to 1.
+func ReadStationsJSON(reader io.Reader) ([]Station, error) {
+	var stations []struct {
+		X   *float64 `json:"x"`
+		Y   *float64 `json:"y"`
+		MRB *int     `json:"mrb"`
+	}
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&stations); err != nil {
+		return nil, err
+	}
+
+	result := make([]Station, len(stations))
+	for i, station := range stations {
+		if station.X == nil || station.Y == nil || station.MRB == nil {
+			return nil, fmt.Errorf("%w: station %d has to define x, y and mrb", ErrInvalidLayout, i)
+		}
+
+		result[i] = Station{Point: Point{X: *station.X, Y: *station.Y}, MRB: *station.MRB}
+	}
+
+	return result, validateStations(result)
+}
+
+func validateStations(stations []Station) error {
+	if len(stations) == 0 {
+		return fmt.Errorf("%w: at least one station is required", ErrInvalidLayout)
+	}
+
+	for i, station := range stations {
+		if station.MRB <= 0 {
+			return fmt.Errorf("%w: MRB of station %d has to be positive", ErrInvalidLayout, i)
+		}
+	}
+
+	return nil
+}
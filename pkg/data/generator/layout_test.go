@@ -0,0 +1,239 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStationLayout_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		layout  StationLayout
+		wantErr bool
+	}{
+		{"should accept square grid", StationLayout{Kind: SquareGrid}, false},
+		{"should accept hexagonal grid", StationLayout{Kind: HexagonalGrid}, false},
+		{"should accept Poisson point process", StationLayout{Kind: PoissonPointProcess}, false},
+		{"should accept roadside layout with default road", StationLayout{Kind: Roadside}, false},
+		{"should accept roadside layout with road", StationLayout{Kind: Roadside, Road: []Point{{0, 0}, {1, 1}}}, false},
+		{"should reject road of single point", StationLayout{Kind: Roadside, Road: []Point{{0, 0}}}, true},
+		{"should reject road of the same points", StationLayout{Kind: Roadside, Road: []Point{{0, 0}, {0, 0}}}, true},
+		{"should accept explicit stations", StationLayout{Kind: Explicit, Stations: []Station{{Point{0, 0}, 1}}}, false},
+		{"should reject missing explicit stations", StationLayout{Kind: Explicit}, true},
+		{"should reject non-positive MRB", StationLayout{Kind: Explicit, Stations: []Station{{Point{0, 0}, 0}}}, true},
+		{"should reject unknown kind", StationLayout{Kind: LayoutKind(100)}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.layout.Validate()
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidLayout)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerator_placeStations(t *testing.T) {
+	t.Parallel()
+
+	for name, layout := range map[string]StationLayout{
+		"square grid":             {Kind: SquareGrid},
+		"hexagonal grid":          {Kind: HexagonalGrid},
+		"Poisson point process":   {Kind: PoissonPointProcess},
+		"roadside":                {Kind: Roadside},
+		"roadside along polyline": {Kind: Roadside, Road: []Point{{0, 0}, {0.5, 0.5}, {1, 0}}},
+	} {
+		layout := layout
+
+		t.Run("should place stations within square - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, n := range []int{1, 2, 7, 20} {
+				stationPoints := NewWithSeed(1).placeStations(layout, n)
+
+				assert.Len(t, stationPoints, n)
+				for i, p := range stationPoints {
+					assertInsideSquare(t, p)
+					for _, other := range stationPoints[:i] {
+						assert.NotEqual(t, other, p)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("should place stations on hexagonal lattice", func(t *testing.T) {
+		t.Parallel()
+
+		stationPoints := NewWithSeed(1).placeStations(StationLayout{Kind: HexagonalGrid}, 12)
+
+		// 12 sites require 4 columns and 4 rows.
+		spacing := 0.25
+		for i, p := range stationPoints {
+			for _, other := range stationPoints[:i] {
+				assert.GreaterOrEqual(t, p.Distance(other), spacing-positionTolerance)
+			}
+		}
+	})
+
+	t.Run("should place stations along road on alternate sides", func(t *testing.T) {
+		t.Parallel()
+
+		stationPoints := NewWithSeed(1).placeStations(StationLayout{Kind: Roadside}, 4)
+
+		assert.InDeltaSlice(t, []float64{0.125, 0.375, 0.625, 0.875}, xs(stationPoints), positionTolerance)
+		assert.InDeltaSlice(t, []float64{0.51, 0.49, 0.51, 0.49}, ys(stationPoints), positionTolerance)
+	})
+
+	t.Run("should place stations along polyline", func(t *testing.T) {
+		t.Parallel()
+
+		road := []Point{{0, 0.2}, {0.6, 0.2}, {0.6, 1}}
+		stationPoints := NewWithSeed(1).placeStations(StationLayout{Kind: Roadside, Road: road}, 2)
+
+		assert.InDelta(t, 0.35, stationPoints[0].X, positionTolerance)
+		assert.InDelta(t, 0.21, stationPoints[0].Y, positionTolerance)
+		assert.InDelta(t, 0.61, stationPoints[1].X, positionTolerance)
+		assert.InDelta(t, 0.65, stationPoints[1].Y, positionTolerance)
+	})
+
+	t.Run("should place explicit stations", func(t *testing.T) {
+		t.Parallel()
+
+		stations := []Station{{Point{0.1, 0.2}, 5}, {Point{0.3, 0.4}, 6}}
+		layout := StationLayout{Kind: Explicit, Stations: stations}
+
+		assert.Equal(t, []Point{{0.1, 0.2}, {0.3, 0.4}}, NewWithSeed(1).placeStations(layout, 10))
+		assert.Equal(t, []int{5, 6}, NewWithSeed(1).generateBuckets(layout, 10, 100, false))
+	})
+}
+
+func TestGenerator_GenerateV2X_layout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate data of explicit stations", func(t *testing.T) {
+		t.Parallel()
+
+		stations := []Station{{Point{0.1, 0.2}, 5}, {Point{0.3, 0.4}, 6}, {Point{0.9, 0.9}, 7}}
+		config := V2XConfig{
+			VehicleCount: 10,
+			BucketCount:  20,
+			BucketSize:   100,
+			Layout:       StationLayout{Kind: Explicit, Stations: stations},
+		}
+
+		result := NewWithSeed(1).GenerateV2X(config)
+
+		assert.Equal(t, []int{5, 6, 7}, result.MRB)
+		assert.Len(t, result.R, 10)
+		for _, sizes := range result.R {
+			assert.Len(t, sizes, 3)
+		}
+	})
+
+	t.Run("should generate scenario with layout", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(Highway)
+		config.Layout = StationLayout{Kind: Roadside}
+
+		scenario := NewWithSeed(1).GenerateV2XMobility(config)
+
+		assert.NoError(t, scenario.Validate())
+		assert.Len(t, scenario.Snapshots[0].MRB, config.BucketCount)
+	})
+}
+
+func TestReadStationsCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should read stations", func(t *testing.T) {
+		t.Parallel()
+
+		stations, err := ReadStationsCSV(strings.NewReader("# x,y,mrb\n0.1, 0.2, 10\n\n0.5,0.5,20\n"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Station{{Point{0.1, 0.2}, 10}, {Point{0.5, 0.5}, 20}}, stations)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"should return error if line is malformed", "0.1,0.2\n"},
+		{"should return error if coordinate is not a number", "a,0.2,10\n"},
+		{"should return error if MRB is not an integer", "0.1,0.2,1.5\n"},
+		{"should return error if MRB is not positive", "0.1,0.2,0\n"},
+		{"should return error if there are no stations", "# x,y,mrb\n"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadStationsCSV(strings.NewReader(tt.content))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReadStationsJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should read stations", func(t *testing.T) {
+		t.Parallel()
+
+		stations, err := ReadStationsJSON(strings.NewReader(`[{"x": 0.1, "y": 0.2, "mrb": 10}, {"x": 0, "y": 1, "mrb": 5}]`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Station{{Point{0.1, 0.2}, 10}, {Point{0, 1}, 5}}, stations)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"should return error if content is not JSON array", `{"x": 0.1}`},
+		{"should return error if field is missing", `[{"x": 0.1, "mrb": 10}]`},
+		{"should return error if field is unknown", `[{"x": 0.1, "y": 0.2, "z": 0, "mrb": 10}]`},
+		{"should return error if MRB is not positive", `[{"x": 0.1, "y": 0.2, "mrb": -1}]`},
+		{"should return error if there are no stations", `[]`},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadStationsJSON(strings.NewReader(tt.content))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func xs(points []Point) []float64 {
+	result := make([]float64, len(points))
+	for i, p := range points {
+		result[i] = p.X
+	}
+	return result
+}
+
+func ys(points []Point) []float64 {
+	result := make([]float64, len(points))
+	for i, p := range points {
+		result[i] = p.Y
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MCS is a modulation and coding scheme that provides DataRate per resource block
+// if the quality of the signal is greater than MinSINR, which is expressed in linear scale.
+type MCS struct {
+	MinSINR  float64
+	DataRate float64
+}
+
+// MCSTable is a list of modulation and coding schemes ordered by MinSINR.
+type MCSTable []MCS
+
+// DefaultMCSTable returns MCSTable used by GenerateV2XEnvironmental.
+func DefaultMCSTable() MCSTable {
+	return MCSTable{
+		{math.Inf(-1), 101.07},
+		{0.4, 147.34},
+		{2.5, 197.53},
+		{4.5, 248.07},
+		{6.5, 321.57},
+		{8.5, 404.26},
+		{10.3, 458.72},
+		{12.3, 558.15},
+		{14.2, 655.59},
+		{15.9, 759.93},
+		{17.8, 859.35},
+		{19.8, 933.19},
+	}
+}
+
+// ReadMCSTable reads MCSTable from CSV lines in form of "min_sinr_db,data_rate", where min_sinr_db
+// is the minimal quality of the signal in dB. Empty lines and lines starting with # are skipped.
+func ReadMCSTable(reader io.Reader) (MCSTable, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+
+	var table MCSTable
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		minSINR, err := strconv.ParseFloat(strings.TrimSpace(record[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse minimal SINR: %w", err)
+		}
+
+		dataRate, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse data rate: %w", err)
+		}
+
+		table = append(table, MCS{MinSINR: fromDB(minSINR), DataRate: dataRate})
+	}
+
+	return table, table.Validate()
+}
+
+// Validate returns an error if the table is empty, it is not ordered by MinSINR or data rates aren't positive.
+func (t MCSTable) Validate() error {
+	if len(t) == 0 {
+		return errors.New("MCS table is empty")
+	}
+
+	for i, mcs := range t {
+		if mcs.DataRate <= 0 {
+			return fmt.Errorf("data rate of MCS %d is not positive", i)
+		}
+
+		if i > 0 && mcs.MinSINR <= t[i-1].MinSINR {
+			return fmt.Errorf("MCS table is not ordered by minimal SINR at MCS %d", i)
+		}
+	}
+
+	return nil
+}
+
+// DataRate returns the data rate of the most efficient scheme whose MinSINR is lower than sinr.
+// If there is no such scheme, the data rate of the most robust one is returned.
+func (t MCSTable) DataRate(sinr float64) float64 {
+	dataRate := t[0].DataRate
+
+	for _, mcs := range t[1:] {
+		if sinr <= mcs.MinSINR {
+			break
+		}
+		dataRate = mcs.DataRate
+	}
+
+	return dataRate
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMCSTable_DataRate(t *testing.T) {
+	t.Parallel()
+
+	table := MCSTable{{1, 10}, {2, 20}, {4, 40}}
+
+	tests := []struct {
+		name string
+		sinr float64
+		want float64
+	}{
+		{"should return the most robust scheme below its threshold", 0.5, 10},
+		{"should return the most robust scheme at the threshold of the next one", 2, 10},
+		{"should return scheme whose threshold is exceeded", 3, 20},
+		{"should return the most efficient scheme above its threshold", 100, 40},
+		{"should return the most efficient scheme for infinite sinr", math.Inf(1), 40},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, table.DataRate(tt.sinr))
+		})
+	}
+}
+
+func TestMCSTable_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		table   MCSTable
+		wantErr bool
+	}{
+		{"should accept default table", DefaultMCSTable(), false},
+		{"should reject empty table", MCSTable{}, true},
+		{"should reject table not ordered by minimal SINR", MCSTable{{1, 10}, {1, 20}}, true},
+		{"should reject non-positive data rate", MCSTable{{1, 10}, {2, 0}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.table.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReadMCSTable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should read table with thresholds in dB", func(t *testing.T) {
+		t.Parallel()
+
+		content := "# min_sinr_db,data_rate\n-10, 50.5\n\n0,100\n10,200\n"
+
+		table, err := ReadMCSTable(strings.NewReader(content))
+
+		assert.NoError(t, err)
+		assert.Len(t, table, 3)
+		assert.InDelta(t, 0.1, table[0].MinSINR, 1e-12)
+		assert.Equal(t, MCS{1, 100}, table[1])
+		assert.Equal(t, MCS{10, 200}, table[2])
+		assert.Equal(t, 50.5, table[0].DataRate)
+	})
+
+	t.Run("should return error if line is malformed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ReadMCSTable(strings.NewReader("0,100\n10\n"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if value is not a number", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ReadMCSTable(strings.NewReader("0,fast\n"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error if table is not ordered", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ReadMCSTable(strings.NewReader("10,100\n0,200\n"))
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,305 @@
+package generator
+
+import (
+	"math"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// MobilityModel defines how vehicles move within the square of GenerateV2XEnvironmental.
+type MobilityModel int
+
+const (
+	// RandomWaypoint moves each vehicle straight to a random destination and then draws the next one.
+	RandomWaypoint MobilityModel = iota
+	// Highway moves vehicles along straight horizontal lanes crossing the middle of the square. Lanes
+	// alternate directions. Vehicles leaving the square are replaced with new ones entering random lanes.
+	Highway
+	// Manhattan moves vehicles along the streets of a regular grid. At each intersection, a vehicle goes straight
+	// with probability 1/2 and turns left or right with probability 1/4 each, turning back only at dead ends.
+	Manhattan
+)
+
+const (
+	laneSpacing = 0.01
+	// positionTolerance absorbs floating-point errors of positions of vehicles moving along streets.
+	positionTolerance = 1e-9
+)
+
+// MobilityConfig defines a scenario generated by GenerateV2XMobility. Speeds are distances traveled
+// in a single step, where the side of the square is equal to 1. LaneCount is used only by Highway
+// and BlockCount, i.e. the number of blocks along each side of the square, is used only by Manhattan.
+// Both of them have to be positive if used. Radio defines how R is computed, DefaultRadioModel
+// is used if it is nil. Layout defines placement of stations, which don't move.
+type MobilityConfig struct {
+	Model              MobilityModel
+	VehicleCount       int
+	BucketCount        int
+	BucketSize         int
+	ConstantBucketSize bool
+	Steps              int
+	MinSpeed           float64
+	MaxSpeed           float64
+	LaneCount          int
+	BlockCount         int
+	Radio              *RadioModel
+	Layout             StationLayout
+}
+
+// GenerateV2XMobility generates scenario using the default Generator seeded with the current time.
+func GenerateV2XMobility(config MobilityConfig) *data.Scenario {
+	return defaultGenerator.GenerateV2XMobility(config)
+}
+
+// GenerateV2XMobility generates a scenario of config.Steps snapshots of vehicles moving according
+// to config.Model. Stations are placed the same way as by GenerateV2X and R is computed
+// from distances between vehicles and stations at each step, so consecutive snapshots are correlated.
+// The number of vehicles is constant. In case of Highway, vehicles leaving the square are replaced with new ones,
+// while vehicles of other models never leave it.
+// The demand of each vehicle is drawn once, while shadowing is drawn independently at each step.
+// Positions of vehicles and stations at each step are stored in the geometry of metadata of snapshots.
+// If config.ConstantBucketSize is not set, bucket sizes are drawn from range [1, config.BucketSize].
+func (g *Generator) GenerateV2XMobility(config MobilityConfig) *data.Scenario {
+	radio := config.Radio
+	if radio == nil {
+		radio = DefaultRadioModel()
+	}
+
+	mrb := g.generateBuckets(config.Layout, config.BucketCount, config.BucketSize, config.ConstantBucketSize)
+	stationPoints := g.placeStations(config.Layout, config.BucketCount)
+	model := g.newMobility(config)
+
+	vehicles := make([]*vehicle, config.VehicleCount)
+	for i := range vehicles {
+		vehicles[i] = model.spawn(i, true)
+		vehicles[i].demand = g.randomDemand(radio)
+	}
+	nextID := len(vehicles)
+
+	scenario := &data.Scenario{Snapshots: make([]data.Snapshot, config.Steps)}
+
+	for step := range scenario.Snapshots {
+		if step > 0 {
+			for i, v := range vehicles {
+				if model.move(v) {
+					continue
+				}
+
+				vehicles[i] = model.spawn(nextID, false)
+				vehicles[i].demand = g.randomDemand(radio)
+				nextID++
+			}
+		}
+
+		scenario.Snapshots[step] = g.toSnapshot(vehicles, stationPoints, mrb, radio)
+	}
+
+	return scenario
+}
+
+func (g *Generator) toSnapshot(vehicles []*vehicle, stationPoints []Point, mrb []int, radio *RadioModel) data.Snapshot {
+	snapshot := data.Snapshot{
+		Data:       data.Data{MRB: make([]int, len(mrb)), R: make([][]int, len(vehicles))},
+		VehicleIDs: make([]int, len(vehicles)),
+	}
+
+	copy(snapshot.MRB, mrb)
+
+	vehiclePoints := make([]Point, len(vehicles))
+	for i, v := range vehicles {
+		snapshot.VehicleIDs[i] = v.id
+		snapshot.R[i] = g.computeR(v.position, stationPoints, v.demand, radio)
+		vehiclePoints[i] = v.position
+	}
+
+	snapshot.Metadata = &data.Metadata{Geometry: toGeometry(vehiclePoints, stationPoints)}
+
+	return snapshot
+}
+
+type vehicle struct {
+	id        int
+	position  Point
+	speed     float64
+	target    Point
+	direction Point
+	demand    float64
+}
+
+// mobility moves vehicles according to a mobility model.
+type mobility interface {
+	// spawn returns a new vehicle. Initial vehicles are placed anywhere, others enter the square.
+	spawn(id int, isInitial bool) *vehicle
+	// move moves the vehicle by a single step and returns false if the vehicle has left the square.
+	move(v *vehicle) bool
+}
+
+func (g *Generator) newMobility(config MobilityConfig) mobility {
+	switch config.Model {
+	case Highway:
+		return &highway{g, config}
+	case Manhattan:
+		return &manhattan{g, config}
+	default:
+		return &randomWaypoint{g, config}
+	}
+}
+
+func (g *Generator) randomSpeed(config MobilityConfig) float64 {
+	return config.MinSpeed + g.random.Float64()*(config.MaxSpeed-config.MinSpeed)
+}
+
+func (g *Generator) randomPoint() Point {
+	return Point{X: g.random.Float64() * squareSideLength, Y: g.random.Float64() * squareSideLength}
+}
+
+type randomWaypoint struct {
+	*Generator
+	config MobilityConfig
+}
+
+func (r *randomWaypoint) spawn(id int, _ bool) *vehicle {
+	return &vehicle{id: id, position: r.randomPoint(), target: r.randomPoint(), speed: r.randomSpeed(r.config)}
+}
+
+func (r *randomWaypoint) move(v *vehicle) bool {
+	remaining := v.speed
+
+	for remaining > 0 {
+		distance := v.position.Distance(v.target)
+		if distance > remaining {
+			v.position = v.position.towards(v.target, remaining/distance)
+			return true
+		}
+
+		v.position = v.target
+		remaining -= distance
+		v.target = r.randomPoint()
+		v.speed = r.randomSpeed(r.config)
+	}
+
+	return true
+}
+
+type highway struct {
+	*Generator
+	config MobilityConfig
+}
+
+func (h *highway) spawn(id int, isInitial bool) *vehicle {
+	lane := h.random.Intn(h.config.LaneCount)
+	y := squareSideLength/2.0 + (float64(lane)-float64(h.config.LaneCount-1)/2)*laneSpacing
+
+	direction := Point{X: 1}
+	x := 0.0
+	if lane%2 == 1 {
+		direction = Point{X: -1}
+		x = squareSideLength
+	}
+
+	if isInitial {
+		x = h.random.Float64() * squareSideLength
+	}
+
+	return &vehicle{id: id, position: Point{X: x, Y: y}, direction: direction, speed: h.randomSpeed(h.config)}
+}
+
+func (h *highway) move(v *vehicle) bool {
+	v.position.X += v.direction.X * v.speed
+	return v.position.X >= 0 && v.position.X <= squareSideLength
+}
+
+type manhattan struct {
+	*Generator
+	config MobilityConfig
+}
+
+var manhattanDirections = []Point{{X: 1}, {Y: 1}, {X: -1}, {Y: -1}}
+
+func (m *manhattan) blockLength() float64 {
+	return squareSideLength / float64(m.config.BlockCount)
+}
+
+func (m *manhattan) spawn(id int, _ bool) *vehicle {
+	street := float64(m.random.Intn(m.config.BlockCount+1)) * m.blockLength()
+	along := m.random.Float64() * squareSideLength
+	direction := manhattanDirections[m.random.Intn(len(manhattanDirections))]
+
+	position := Point{X: along, Y: street}
+	if direction.X == 0 {
+		position = Point{X: street, Y: along}
+	}
+
+	v := &vehicle{id: id, position: position, direction: direction, speed: m.randomSpeed(m.config)}
+	if !isInsideSquare(m.nextIntersection(v)) {
+		v.direction = Point{X: -direction.X, Y: -direction.Y}
+	}
+
+	return v
+}
+
+func (m *manhattan) move(v *vehicle) bool {
+	remaining := v.speed
+
+	for remaining > 0 {
+		intersection := m.nextIntersection(v)
+		distance := v.position.Distance(intersection)
+
+		if distance > remaining {
+			v.position = v.position.towards(intersection, remaining/distance)
+			return true
+		}
+
+		v.position = intersection
+		remaining -= distance
+		v.direction = m.turn(v)
+	}
+
+	return true
+}
+
+// nextIntersection returns the first intersection in the direction of the vehicle.
+func (m *manhattan) nextIntersection(v *vehicle) Point {
+	block := m.blockLength()
+	next := func(coordinate, direction float64) float64 {
+		switch {
+		case direction > 0:
+			return (math.Floor(coordinate/block+positionTolerance) + 1) * block
+		case direction < 0:
+			return (math.Ceil(coordinate/block-positionTolerance) - 1) * block
+		default:
+			return coordinate
+		}
+	}
+
+	return Point{X: next(v.position.X, v.direction.X), Y: next(v.position.Y, v.direction.Y)}
+}
+
+// turn draws the direction of the vehicle at an intersection among directions that don't leave the square.
+func (m *manhattan) turn(v *vehicle) Point {
+	straight := v.direction
+	left := Point{X: -v.direction.Y, Y: v.direction.X}
+	right := Point{X: v.direction.Y, Y: -v.direction.X}
+	back := Point{X: -v.direction.X, Y: -v.direction.Y}
+
+	candidates := []Point{straight, straight, left, right}
+	allowed := candidates[:0]
+
+	for _, direction := range candidates {
+		next := Point{X: v.position.X + direction.X*m.blockLength(), Y: v.position.Y + direction.Y*m.blockLength()}
+		if isInsideSquare(next) {
+			allowed = append(allowed, direction)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return back
+	}
+
+	return allowed[m.random.Intn(len(allowed))]
+}
+
+// towards returns the point moved towards the target by the given fraction of the distance between them.
+func (p Point) towards(target Point, fraction float64) Point {
+	return Point{X: p.X + (target.X-p.X)*fraction, Y: p.Y + (target.Y-p.Y)*fraction}
+}
@@ -0,0 +1,185 @@
+package generator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testMobilityConfig(model MobilityModel) MobilityConfig {
+	return MobilityConfig{
+		Model:        model,
+		VehicleCount: 20,
+		BucketCount:  9,
+		BucketSize:   100,
+		Steps:        30,
+		MinSpeed:     0.01,
+		MaxSpeed:     0.05,
+		LaneCount:    4,
+		BlockCount:   5,
+	}
+}
+
+func TestGenerator_GenerateV2XMobility(t *testing.T) {
+	t.Parallel()
+
+	for name, model := range map[string]MobilityModel{
+		"random waypoint": RandomWaypoint,
+		"highway":         Highway,
+		"manhattan":       Manhattan,
+	} {
+		model := model
+
+		t.Run("should generate valid scenario - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testMobilityConfig(model)
+
+			scenario := NewWithSeed(1).GenerateV2XMobility(config)
+
+			assert.NoError(t, scenario.Validate())
+			assert.Len(t, scenario.Snapshots, config.Steps)
+
+			expectedMaxItemSize := defaultDemand / DefaultMCSTable().DataRate(0)
+
+			for _, snapshot := range scenario.Snapshots {
+				assert.Len(t, snapshot.R, config.VehicleCount)
+				assert.Equal(t, scenario.Snapshots[0].MRB, snapshot.MRB)
+				assert.Len(t, snapshot.Metadata.Geometry.Vehicles, config.VehicleCount)
+				assert.Len(t, snapshot.Metadata.Geometry.Stations, config.BucketCount)
+
+				for _, costs := range snapshot.R {
+					assert.Len(t, costs, config.BucketCount)
+					for _, cost := range costs {
+						assert.GreaterOrEqual(t, cost, 1)
+						assert.LessOrEqual(t, float64(cost), expectedMaxItemSize)
+					}
+				}
+			}
+		})
+
+		t.Run("should generate the same scenario for the same seed - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testMobilityConfig(model)
+
+			assert.Equal(t, NewWithSeed(3).GenerateV2XMobility(config), NewWithSeed(3).GenerateV2XMobility(config))
+			assert.NotEqual(t, NewWithSeed(3).GenerateV2XMobility(config), NewWithSeed(4).GenerateV2XMobility(config))
+		})
+	}
+
+	t.Run("should generate buckets of constant size", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(RandomWaypoint)
+		config.ConstantBucketSize = true
+
+		scenario := GenerateV2XMobility(config)
+
+		for _, size := range scenario.Snapshots[0].MRB {
+			assert.Equal(t, config.BucketSize, size)
+		}
+	})
+
+	t.Run("should replace vehicles leaving highway with new ones", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(Highway)
+		config.MinSpeed, config.MaxSpeed = 0.2, 0.3
+
+		scenario := NewWithSeed(1).GenerateV2XMobility(config)
+
+		first, last := scenario.Snapshots[0].VehicleIDs, scenario.Snapshots[config.Steps-1].VehicleIDs
+		for _, id := range last {
+			assert.NotContains(t, first, id)
+		}
+	})
+}
+
+func Test_randomWaypoint_move(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should move vehicle by its speed within square", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(RandomWaypoint)
+		model := NewWithSeed(1).newMobility(config)
+
+		v := model.spawn(0, true)
+		for i := 0; i < 100; i++ {
+			previous, speed := v.position, v.speed
+
+			assert.True(t, model.move(v))
+			assert.LessOrEqual(t, previous.Distance(v.position), speed+positionTolerance)
+			assertInsideSquare(t, v.position)
+		}
+	})
+}
+
+func Test_highway_move(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should move vehicles along lanes until they leave square", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(Highway)
+		model := NewWithSeed(1).newMobility(config)
+
+		for id := 0; id < 20; id++ {
+			v := model.spawn(id, false)
+			y := v.position.Y
+
+			assert.True(t, v.position.X == 0 || v.position.X == squareSideLength)
+			assert.InDelta(t, squareSideLength/2.0, y, float64(config.LaneCount)*laneSpacing/2)
+
+			steps := 0
+			for model.move(v) {
+				assert.Equal(t, y, v.position.Y)
+				steps++
+			}
+
+			assert.LessOrEqual(t, steps, int(math.Ceil(squareSideLength/config.MinSpeed)))
+		}
+	})
+}
+
+func Test_manhattan_move(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should move vehicles along streets within square", func(t *testing.T) {
+		t.Parallel()
+
+		config := testMobilityConfig(Manhattan)
+		model := NewWithSeed(1).newMobility(config).(*manhattan)
+
+		for id := 0; id < 20; id++ {
+			v := model.spawn(id, true)
+
+			for i := 0; i < 100; i++ {
+				previous := v.position
+
+				assert.True(t, model.move(v))
+				assert.LessOrEqual(t, math.Abs(previous.X-v.position.X)+math.Abs(previous.Y-v.position.Y),
+					v.speed+positionTolerance)
+				assertInsideSquare(t, v.position)
+				assert.True(t, isOnStreet(v.position.X, model.blockLength()) || isOnStreet(v.position.Y, model.blockLength()),
+					"vehicle at %v is not on street", v.position)
+			}
+		}
+	})
+}
+
+func assertInsideSquare(t *testing.T, p Point) {
+	t.Helper()
+
+	assert.GreaterOrEqual(t, p.X, -positionTolerance)
+	assert.LessOrEqual(t, p.X, squareSideLength+positionTolerance)
+	assert.GreaterOrEqual(t, p.Y, -positionTolerance)
+	assert.LessOrEqual(t, p.Y, squareSideLength+positionTolerance)
+}
+
+func isOnStreet(coordinate, blockLength float64) bool {
+	blocks := coordinate / blockLength
+	return math.Abs(blocks-math.Round(blocks)) < positionTolerance
+}
@@ -6,26 +6,36 @@ import (
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
 )
 
+// GenerateNormal generates data using the default Generator seeded with the current time.
 func GenerateNormal(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeNormal)
-	bucketSizes := generateBucketsWithSizes(bucketCount, maxBucketSize)
+	return defaultGenerator.GenerateNormal(itemCount, maxItemSize, bucketCount, maxBucketSize)
+}
+
+// GenerateNormalConstantBucketSize generates data using the default Generator seeded with the current time.
+func GenerateNormalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	return defaultGenerator.GenerateNormalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize)
+}
+
+func (g *Generator) GenerateNormal(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeNormal)
+	bucketSizes := g.generateBucketsWithSizes(bucketCount, maxBucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func GenerateNormalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeNormal)
+func (g *Generator) GenerateNormalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeNormal)
 	bucketSizes := generateBucketsOfConstantSize(bucketCount, bucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func generateItemSizeNormal(maxItemSize int) int {
+func (g *Generator) generateItemSizeNormal(maxItemSize int) int {
 	mean := float64(maxItemSize) / 2
 	stdDev := float64(maxItemSize) / 3
 
 	for {
-		normValue := random.NormFloat64()*stdDev + mean
+		normValue := g.random.NormFloat64()*stdDev + mean
 		flooredValue := math.Ceil(normValue)
 		itemSize := int(flooredValue)
 
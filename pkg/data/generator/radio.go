@@ -0,0 +1,252 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidRadioModel is returned if parameters of RadioModel are out of their domains.
+var ErrInvalidRadioModel = errors.New("invalid radio model")
+
+const (
+	defaultTransmitPower     = 2
+	defaultPathLossExponent  = 3.5
+	defaultDemand            = 1 * 1024
+	defaultReferenceDistance = 1
+	// defaultBandwidth is the bandwidth in Hz of a single resource block.
+	defaultBandwidth      = 180e3
+	defaultNoiseFigure    = 9
+	defaultActivityFactor = 1
+
+	// thermalNoiseDensity is the power spectral density of thermal noise in dBm/Hz at the room temperature.
+	thermalNoiseDensity = -174
+
+	speedOfLight = 299792458
+	// userTerminalHeight is the height of vehicle antennas in meters.
+	userTerminalHeight = 1.5
+	// environmentHeight is the effective environment height in meters used to compute the breakpoint distance.
+	environmentHeight = 1
+	// minUrbanDistance is the minimal 2D distance in meters for which 3GPP urban path-loss formulas are valid.
+	minUrbanDistance = 10
+	umiStationHeight = 10
+	umaStationHeight = 25
+)
+
+// PathLossModel returns the gain of the channel, i.e. the inverse of the path loss in linear scale,
+// between a station and a vehicle at the given distance.
+type PathLossModel func(distance float64) float64
+
+// RadioModel defines how R is computed from the distance between a vehicle and a station. The quality of
+// the signal is equal to the received power, i.e. TransmitPower multiplied by the gain of PathLoss
+// and the shadowing factor. In SINR mode, the quality is equal to the received power divided by the sum
+// of the thermal noise power and the interference, i.e. the power received from all other stations
+// multiplied by ActivityFactor, which is the probability that a station transmits in the same resource block.
+// The data rate of a single resource block is taken from MCSTable for that quality and R is the number
+// of resource blocks needed to satisfy the demand of the vehicle, which is drawn uniformly
+// from range [MinDemand, MaxDemand]. Distances within the square, whose side is equal to 1,
+// are multiplied by AreaSideLength before computing the path loss, so it defines the unit of distance
+// used by PathLoss, e.g. meters in case of FreeSpace and 3GPP models.
+type RadioModel struct {
+	// TransmitPower is the transmit power of stations in linear scale relative to the power of noise.
+	// In SINR mode, it is expressed in mW.
+	TransmitPower  float64
+	AreaSideLength float64
+	PathLoss       PathLossModel
+	// ShadowingStdDev is the standard deviation in dB of log-normal shadowing drawn independently
+	// for each pair of a vehicle and a station. Shadowing is disabled if it is equal to 0.
+	ShadowingStdDev float64
+	MinDemand       float64
+	MaxDemand       float64
+	MCSTable        MCSTable
+	SINR            bool
+	// Bandwidth in Hz and NoiseFigure in dB of receivers define the thermal noise power in SINR mode.
+	Bandwidth      float64
+	NoiseFigure    float64
+	ActivityFactor float64
+}
+
+// DefaultRadioModel returns RadioModel used by GenerateV2XEnvironmental.
+func DefaultRadioModel() *RadioModel {
+	return &RadioModel{
+		TransmitPower:  defaultTransmitPower,
+		AreaSideLength: squareSideLength,
+		PathLoss:       LogDistance(defaultPathLossExponent, defaultReferenceDistance, 0),
+		MinDemand:      defaultDemand,
+		MaxDemand:      defaultDemand,
+		MCSTable:       DefaultMCSTable(),
+		Bandwidth:      defaultBandwidth,
+		NoiseFigure:    defaultNoiseFigure,
+		ActivityFactor: defaultActivityFactor,
+	}
+}
+
+// Validate returns ErrInvalidRadioModel if any parameter of the model is out of its domain.
+func (m *RadioModel) Validate() error {
+	switch {
+	case m.TransmitPower <= 0:
+		return fmt.Errorf("%w: transmit power has to be positive", ErrInvalidRadioModel)
+	case m.AreaSideLength <= 0:
+		return fmt.Errorf("%w: area side length has to be positive", ErrInvalidRadioModel)
+	case m.PathLoss == nil:
+		return fmt.Errorf("%w: path loss model is required", ErrInvalidRadioModel)
+	case m.ShadowingStdDev < 0:
+		return fmt.Errorf("%w: shadowing standard deviation cannot be negative", ErrInvalidRadioModel)
+	case m.MinDemand <= 0 || m.MaxDemand < m.MinDemand:
+		return fmt.Errorf("%w: demand range [%v, %v] is invalid", ErrInvalidRadioModel, m.MinDemand, m.MaxDemand)
+	case m.SINR && m.Bandwidth <= 0:
+		return fmt.Errorf("%w: bandwidth has to be positive", ErrInvalidRadioModel)
+	case m.SINR && m.NoiseFigure < 0:
+		return fmt.Errorf("%w: noise figure cannot be negative", ErrInvalidRadioModel)
+	case m.SINR && (m.ActivityFactor < 0 || m.ActivityFactor > 1):
+		return fmt.Errorf("%w: activity factor has to be in range [0, 1]", ErrInvalidRadioModel)
+	}
+
+	if err := m.MCSTable.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRadioModel, err.Error())
+	}
+
+	return nil
+}
+
+// receivedPower returns the power received by a vehicle at the given distance from a station,
+// where shadowing is the shadowing factor in linear scale.
+func (m *RadioModel) receivedPower(distance, shadowing float64) float64 {
+	return m.TransmitPower * m.PathLoss(distance*m.AreaSideLength) * shadowing
+}
+
+// signalQuality returns the quality of the signal of the station of the given index,
+// where receivedPowers are powers received by a vehicle from all stations.
+func (m *RadioModel) signalQuality(receivedPowers []float64, station int) float64 {
+	if !m.SINR {
+		return receivedPowers[station]
+	}
+
+	var interference float64
+	for i, power := range receivedPowers {
+		if i != station {
+			interference += power
+		}
+	}
+
+	return receivedPowers[station] / (m.thermalNoisePower() + m.ActivityFactor*interference)
+}
+
+// thermalNoisePower returns the thermal noise power in mW of receivers.
+func (m *RadioModel) thermalNoisePower() float64 {
+	return fromDB(thermalNoiseDensity + 10*math.Log10(m.Bandwidth) + m.NoiseFigure)
+}
+
+// resourceBlocks returns the number of resource blocks needed to satisfy the demand
+// if the signal is of the given quality.
+func (m *RadioModel) resourceBlocks(quality, demand float64) int {
+	return int(math.Ceil(demand / m.MCSTable.DataRate(quality)))
+}
+
+func (g *Generator) randomDemand(m *RadioModel) float64 {
+	if m.MinDemand == m.MaxDemand {
+		return m.MinDemand
+	}
+
+	return m.MinDemand + g.random.Float64()*(m.MaxDemand-m.MinDemand)
+}
+
+func (g *Generator) randomShadowing(m *RadioModel) float64 {
+	if m.ShadowingStdDev == 0 {
+		return 1
+	}
+
+	return fromDB(g.random.NormFloat64() * m.ShadowingStdDev)
+}
+
+// LogDistance returns the log-distance path-loss model with the given path-loss exponent,
+// where referenceLoss is the path loss in dB at referenceDistance.
+func LogDistance(exponent, referenceDistance, referenceLoss float64) PathLossModel {
+	return func(distance float64) float64 {
+		return fromDB(-referenceLoss) * math.Pow(distance/referenceDistance, -exponent)
+	}
+}
+
+// FreeSpace returns the free-space path-loss model for the given carrier frequency in GHz.
+// Distances are expressed in meters.
+func FreeSpace(frequency float64) PathLossModel {
+	return func(distance float64) float64 {
+		return fromDB(-(32.45 + 20*math.Log10(distance) + 20*math.Log10(frequency)))
+	}
+}
+
+// UMiLOS returns the 3GPP TR 38.901 urban micro street canyon path-loss model with line of sight
+// for the given carrier frequency in GHz. Distances are expressed in meters and are not shorter than 10 m.
+func UMiLOS(frequency float64) PathLossModel {
+	return func(distance float64) float64 {
+		return fromDB(-umiLOSPathLoss(distance, frequency))
+	}
+}
+
+// UMiNLOS returns the 3GPP TR 38.901 urban micro street canyon path-loss model without line of sight
+// for the given carrier frequency in GHz. Distances are expressed in meters and are not shorter than 10 m.
+func UMiNLOS(frequency float64) PathLossModel {
+	return func(distance float64) float64 {
+		d := distance3D(distance, umiStationHeight)
+		nlos := 35.3*math.Log10(d) + 22.4 + 21.3*math.Log10(frequency) - 0.3*(userTerminalHeight-1.5)
+		return fromDB(-math.Max(umiLOSPathLoss(distance, frequency), nlos))
+	}
+}
+
+// UMaLOS returns the 3GPP TR 38.901 urban macro path-loss model with line of sight for the given
+// carrier frequency in GHz. Distances are expressed in meters and are not shorter than 10 m.
+func UMaLOS(frequency float64) PathLossModel {
+	return func(distance float64) float64 {
+		return fromDB(-umaLOSPathLoss(distance, frequency))
+	}
+}
+
+// UMaNLOS returns the 3GPP TR 38.901 urban macro path-loss model without line of sight for the given
+// carrier frequency in GHz. Distances are expressed in meters and are not shorter than 10 m.
+func UMaNLOS(frequency float64) PathLossModel {
+	return func(distance float64) float64 {
+		d := distance3D(distance, umaStationHeight)
+		nlos := 13.54 + 39.08*math.Log10(d) + 20*math.Log10(frequency) - 0.6*(userTerminalHeight-1.5)
+		return fromDB(-math.Max(umaLOSPathLoss(distance, frequency), nlos))
+	}
+}
+
+func umiLOSPathLoss(distance, frequency float64) float64 {
+	d := distance3D(distance, umiStationHeight)
+	breakpoint := breakpointDistance(umiStationHeight, frequency)
+
+	if math.Max(distance, minUrbanDistance) <= breakpoint {
+		return 32.4 + 21*math.Log10(d) + 20*math.Log10(frequency)
+	}
+
+	return 32.4 + 40*math.Log10(d) + 20*math.Log10(frequency) -
+		9.5*math.Log10(math.Pow(breakpoint, 2)+math.Pow(umiStationHeight-userTerminalHeight, 2))
+}
+
+func umaLOSPathLoss(distance, frequency float64) float64 {
+	d := distance3D(distance, umaStationHeight)
+	breakpoint := breakpointDistance(umaStationHeight, frequency)
+
+	if math.Max(distance, minUrbanDistance) <= breakpoint {
+		return 28 + 22*math.Log10(d) + 20*math.Log10(frequency)
+	}
+
+	return 28 + 40*math.Log10(d) + 20*math.Log10(frequency) -
+		9*math.Log10(math.Pow(breakpoint, 2)+math.Pow(umaStationHeight-userTerminalHeight, 2))
+}
+
+// distance3D returns the distance between antennas of a station of the given height and a vehicle
+// at the given 2D distance, which is not shorter than the minimal distance of urban path-loss formulas.
+func distance3D(distance, stationHeight float64) float64 {
+	distance = math.Max(distance, minUrbanDistance)
+	return math.Sqrt(math.Pow(distance, 2) + math.Pow(stationHeight-userTerminalHeight, 2))
+}
+
+func breakpointDistance(stationHeight, frequency float64) float64 {
+	return 4 * (stationHeight - environmentHeight) * (userTerminalHeight - environmentHeight) *
+		frequency * 1e9 / speedOfLight
+}
+
+func fromDB(value float64) float64 {
+	return math.Pow(10, value/10)
+}
@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRadioModel_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		modify  func(m *RadioModel)
+		wantErr bool
+	}{
+		{"should accept default model", func(m *RadioModel) {}, false},
+		{"should reject non-positive transmit power", func(m *RadioModel) { m.TransmitPower = 0 }, true},
+		{"should reject non-positive area side length", func(m *RadioModel) { m.AreaSideLength = -1 }, true},
+		{"should reject missing path loss", func(m *RadioModel) { m.PathLoss = nil }, true},
+		{"should reject negative shadowing", func(m *RadioModel) { m.ShadowingStdDev = -1 }, true},
+		{"should reject non-positive demand", func(m *RadioModel) { m.MinDemand = 0 }, true},
+		{"should reject empty demand range", func(m *RadioModel) { m.MaxDemand = m.MinDemand - 1 }, true},
+		{"should reject invalid MCS table", func(m *RadioModel) { m.MCSTable = nil }, true},
+		{"should accept SINR mode", func(m *RadioModel) { m.SINR = true }, false},
+		{"should reject non-positive bandwidth in SINR mode", func(m *RadioModel) {
+			m.SINR = true
+			m.Bandwidth = 0
+		}, true},
+		{"should reject negative noise figure in SINR mode", func(m *RadioModel) {
+			m.SINR = true
+			m.NoiseFigure = -1
+		}, true},
+		{"should reject activity factor out of range in SINR mode", func(m *RadioModel) {
+			m.SINR = true
+			m.ActivityFactor = 1.5
+		}, true},
+		{"should ignore noise parameters if SINR mode is disabled", func(m *RadioModel) { m.Bandwidth = 0 }, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			model := DefaultRadioModel()
+			tt.modify(model)
+
+			err := model.Validate()
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidRadioModel)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRadioModel_resourceBlocks(t *testing.T) {
+	t.Parallel()
+
+	model := &RadioModel{
+		TransmitPower:  10,
+		AreaSideLength: 100,
+		PathLoss:       LogDistance(2, 1, 20),
+		MCSTable:       MCSTable{{math.Inf(-1), 1}, {1, 10}},
+	}
+
+	resourceBlocks := func(distance, shadowing float64) int {
+		quality := model.signalQuality([]float64{model.receivedPower(distance, shadowing)}, 0)
+		return model.resourceBlocks(quality, 25)
+	}
+
+	t.Run("should use the most efficient scheme close to station", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 3, resourceBlocks(0.001, 1))
+	})
+
+	t.Run("should use the most robust scheme far from station", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 25, resourceBlocks(0.01, 1))
+	})
+
+	t.Run("should take shadowing into account", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 3, resourceBlocks(0.01, 20))
+	})
+}
+
+func TestRadioModel_signalQuality(t *testing.T) {
+	t.Parallel()
+
+	receivedPowers := []float64{8e-9, 2e-9, 1e-9}
+
+	t.Run("should return received power if SINR mode is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		model := DefaultRadioModel()
+
+		assert.Equal(t, 2e-9, model.signalQuality(receivedPowers, 1))
+	})
+
+	t.Run("should divide received power by noise and interference in SINR mode", func(t *testing.T) {
+		t.Parallel()
+
+		model := DefaultRadioModel()
+		model.SINR = true
+		model.Bandwidth = 1e7
+		model.NoiseFigure = 4
+		model.ActivityFactor = 0.5
+
+		noise := math.Pow(10, -10)
+		assert.InDelta(t, noise, model.thermalNoisePower(), 1e-22)
+
+		expected := 8e-9 / (noise + 0.5*3e-9)
+		assert.InDelta(t, expected, model.signalQuality(receivedPowers, 0), 1e-9)
+	})
+
+	t.Run("should ignore interference if activity factor is equal to 0", func(t *testing.T) {
+		t.Parallel()
+
+		model := DefaultRadioModel()
+		model.SINR = true
+		model.ActivityFactor = 0
+
+		assert.InDelta(t, 1e-9/model.thermalNoisePower(), model.signalQuality(receivedPowers, 2), 1e-9)
+	})
+}
+
+func TestPathLossModels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		model    PathLossModel
+		distance float64
+		wantDB   float64
+	}{
+		{"log-distance at reference distance", LogDistance(3, 10, 40), 10, 40},
+		{"log-distance at tenfold reference distance", LogDistance(3, 10, 40), 100, 70},
+		{"free space at 1 km and 1 GHz", FreeSpace(1), 1000, 92.45},
+		{"UMi LOS before breakpoint", UMiLOS(2), 100, 32.4 + 21*math.Log10(math.Sqrt(100*100+8.5*8.5)) + 20*math.Log10(2)},
+		{"UMi NLOS is not lower than LOS", UMiNLOS(2), 100, 35.3*math.Log10(math.Sqrt(100*100+8.5*8.5)) + 22.4 +
+			21.3*math.Log10(2)},
+		{"UMa LOS before breakpoint", UMaLOS(2), 100, 28 + 22*math.Log10(math.Sqrt(100*100+23.5*23.5)) + 20*math.Log10(2)},
+		{"UMa NLOS is not lower than LOS", UMaNLOS(2), 100, 13.54 + 39.08*math.Log10(math.Sqrt(100*100+23.5*23.5)) +
+			20*math.Log10(2)},
+		{"UMi LOS at distance shorter than minimal one", UMiLOS(2), 1,
+			32.4 + 21*math.Log10(math.Sqrt(10*10+8.5*8.5)) + 20*math.Log10(2)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run("should compute "+tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tt.wantDB, -10*math.Log10(tt.model(tt.distance)), 1e-9)
+		})
+	}
+
+	t.Run("should compute UMi LOS after breakpoint", func(t *testing.T) {
+		t.Parallel()
+
+		breakpoint := breakpointDistance(umiStationHeight, 2)
+		distance := 2 * breakpoint
+		d := math.Sqrt(distance*distance + 8.5*8.5)
+		expected := 32.4 + 40*math.Log10(d) + 20*math.Log10(2) - 9.5*math.Log10(breakpoint*breakpoint+8.5*8.5)
+
+		assert.InDelta(t, expected, -10*math.Log10(UMiLOS(2)(distance)), 1e-9)
+	})
+
+	t.Run("should decrease gain with distance", func(t *testing.T) {
+		t.Parallel()
+
+		for _, model := range []PathLossModel{FreeSpace(5.9), UMiLOS(5.9), UMiNLOS(5.9), UMaLOS(5.9), UMaNLOS(5.9)} {
+			previous := model(10)
+			for distance := 20.0; distance < 2000; distance += 10 {
+				gain := model(distance)
+				assert.Less(t, gain, previous)
+				previous = gain
+			}
+		}
+	})
+}
+
+func TestGenerator_GenerateV2X(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate the same data as GenerateV2XEnvironmental by default", func(t *testing.T) {
+		t.Parallel()
+
+		config := V2XConfig{VehicleCount: 20, BucketCount: 6, BucketSize: 100}
+
+		assert.Equal(t, NewWithSeed(5).GenerateV2XEnvironmental(20, 0, 6, 100), NewWithSeed(5).GenerateV2X(config))
+	})
+
+	t.Run("should generate demands within range", func(t *testing.T) {
+		t.Parallel()
+
+		radio := DefaultRadioModel()
+		radio.MinDemand, radio.MaxDemand = 100, 200
+		radio.MCSTable = MCSTable{{math.Inf(-1), 10}}
+
+		result := NewWithSeed(1).GenerateV2X(V2XConfig{VehicleCount: 50, BucketCount: 4, BucketSize: 10, Radio: radio})
+
+		for _, sizes := range result.R {
+			for _, size := range sizes {
+				assert.Equal(t, sizes[0], size)
+				assert.GreaterOrEqual(t, size, 10)
+				assert.LessOrEqual(t, size, 20)
+			}
+		}
+	})
+
+	t.Run("should generate bigger items in SINR mode with interference", func(t *testing.T) {
+		t.Parallel()
+
+		radio := DefaultRadioModel()
+		radio.SINR = true
+		radio.TransmitPower = 200
+		radio.AreaSideLength = 1000
+		radio.PathLoss = UMiLOS(5.9)
+		radio.ActivityFactor = 0
+
+		config := V2XConfig{VehicleCount: 50, BucketCount: 9, BucketSize: 10, Radio: radio}
+		withoutInterference := NewWithSeed(1).GenerateV2X(config)
+
+		radio.ActivityFactor = 1
+		withInterference := NewWithSeed(1).GenerateV2X(config)
+
+		var sumWithout, sumWith int
+		for i := range withInterference.R {
+			for j := range withInterference.R[i] {
+				assert.GreaterOrEqual(t, withInterference.R[i][j], withoutInterference.R[i][j])
+				sumWithout += withoutInterference.R[i][j]
+				sumWith += withInterference.R[i][j]
+			}
+		}
+		assert.Greater(t, sumWith, sumWithout)
+	})
+
+	t.Run("should generate different sizes with shadowing", func(t *testing.T) {
+		t.Parallel()
+
+		radio := DefaultRadioModel()
+		radio.ShadowingStdDev = 8
+
+		config := V2XConfig{VehicleCount: 50, BucketCount: 4, BucketSize: 10}
+		withoutShadowing := NewWithSeed(1).GenerateV2X(config)
+
+		config.Radio = radio
+		withShadowing := NewWithSeed(1).GenerateV2X(config)
+
+		assert.NotEqual(t, withoutShadowing.R, withShadowing.R)
+	})
+}
@@ -2,20 +2,30 @@ package generator
 
 import "github.com/lothar1998/v2x-optimizer/pkg/data"
 
+// GenerateUniform generates data using the default Generator seeded with the current time.
 func GenerateUniform(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeUniform)
-	bucketSizes := generateBucketsWithSizes(bucketCount, maxBucketSize)
+	return defaultGenerator.GenerateUniform(itemCount, maxItemSize, bucketCount, maxBucketSize)
+}
+
+// GenerateUniformConstantBucketSize generates data using the default Generator seeded with the current time.
+func GenerateUniformConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	return defaultGenerator.GenerateUniformConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize)
+}
+
+func (g *Generator) GenerateUniform(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeUniform)
+	bucketSizes := g.generateBucketsWithSizes(bucketCount, maxBucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func GenerateUniformConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
-	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, generateItemSizeUniform)
+func (g *Generator) GenerateUniformConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	itemSizes := generateItemSizes(itemCount, maxItemSize, bucketCount, g.generateItemSizeUniform)
 	bucketSizes := generateBucketsOfConstantSize(bucketCount, bucketSize)
 
 	return &data.Data{R: itemSizes, MRB: bucketSizes}
 }
 
-func generateItemSizeUniform(maxItemSize int) int {
-	return random.Intn(maxItemSize) + 1
+func (g *Generator) generateItemSizeUniform(maxItemSize int) int {
+	return g.random.Intn(maxItemSize) + 1
 }
@@ -6,50 +6,109 @@ import (
 	"github.com/lothar1998/v2x-optimizer/pkg/data"
 )
 
-const (
-	squareSideLength = 1
-	bigP             = 2
-	alpha            = 3.5
-	rate             = 1 * 1024
-)
+const squareSideLength = 1
+
+// V2XConfig defines data generated by GenerateV2X. Vehicles are placed within the square, whose side
+// is equal to 1, according to Vehicles, by default uniformly, and stations are placed according to Layout,
+// by default in centers of cells of a square grid. If ConstantBucketSize is not set, bucket sizes are drawn from range
+// [1, BucketSize]. Radio defines how R is computed, DefaultRadioModel is used if it is nil.
+type V2XConfig struct {
+	VehicleCount       int
+	BucketCount        int
+	BucketSize         int
+	ConstantBucketSize bool
+	Radio              *RadioModel
+	Layout             StationLayout
+	Vehicles           VehicleDistribution
+}
 
-func GenerateV2XEnvironmental(itemCount, _, bucketCount, maxBucketSize int) *data.Data {
-	itemSizes := generateItemSizesV2XEnvironmental(itemCount, bucketCount)
-	bucketSizes := generateBucketsWithSizes(bucketCount, maxBucketSize)
+// GenerateV2XEnvironmental generates data using the default Generator seeded with the current time.
+func GenerateV2XEnvironmental(itemCount, maxItemSize, bucketCount, maxBucketSize int) *data.Data {
+	return defaultGenerator.GenerateV2XEnvironmental(itemCount, maxItemSize, bucketCount, maxBucketSize)
+}
 
-	return &data.Data{R: itemSizes, MRB: bucketSizes}
+// GenerateV2XEnvironmentalConstantBucketSize generates data using the default Generator seeded with the current time.
+func GenerateV2XEnvironmentalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize int) *data.Data {
+	return defaultGenerator.GenerateV2XEnvironmentalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize)
 }
 
-func GenerateV2XEnvironmentalConstantBucketSize(itemCount, _, bucketCount, bucketSize int) *data.Data {
-	itemSizes := generateItemSizesV2XEnvironmental(itemCount, bucketCount)
-	bucketSizes := generateBucketsOfConstantSize(bucketCount, bucketSize)
+// GenerateV2X generates data using the default Generator seeded with the current time.
+func GenerateV2X(config V2XConfig) *data.Data {
+	return defaultGenerator.GenerateV2X(config)
+}
 
-	return &data.Data{R: itemSizes, MRB: bucketSizes}
+func (g *Generator) GenerateV2XEnvironmental(itemCount, _, bucketCount, maxBucketSize int) *data.Data {
+	return g.GenerateV2X(V2XConfig{VehicleCount: itemCount, BucketCount: bucketCount, BucketSize: maxBucketSize})
 }
 
-func generateItemSizesV2XEnvironmental(itemCount, bucketCount int) [][]int {
-	vehiclePoints := generateVehiclePoints(itemCount)
-	stationPoints := generateStationPoints(bucketCount)
+func (g *Generator) GenerateV2XEnvironmentalConstantBucketSize(itemCount, _, bucketCount, bucketSize int) *data.Data {
+	return g.GenerateV2X(V2XConfig{
+		VehicleCount:       itemCount,
+		BucketCount:        bucketCount,
+		BucketSize:         bucketSize,
+		ConstantBucketSize: true,
+	})
+}
+
+// GenerateV2X generates data of vehicles and stations placed within the square according to the config.
+// Positions of vehicles and stations are stored in the geometry of metadata of data.
+func (g *Generator) GenerateV2X(config V2XConfig) *data.Data {
+	radio := config.Radio
+	if radio == nil {
+		radio = DefaultRadioModel()
+	}
+
+	vehiclePoints := g.placeVehicles(config.Vehicles, config.VehicleCount)
+	stationPoints := g.placeStations(config.Layout, config.BucketCount)
 
-	itemSizes := make([][]int, itemCount)
+	itemSizes := make([][]int, len(vehiclePoints))
 	for i := range itemSizes {
-		itemSizes[i] = make([]int, bucketCount)
-		for j := range itemSizes[i] {
-			itemSizes[i][j] = computeR(vehiclePoints[i], stationPoints[j])
+		itemSizes[i] = g.computeR(vehiclePoints[i], stationPoints, g.randomDemand(radio), radio)
+	}
+
+	bucketSizes := g.generateBuckets(config.Layout, config.BucketCount, config.BucketSize, config.ConstantBucketSize)
+
+	return &data.Data{
+		R:        itemSizes,
+		MRB:      bucketSizes,
+		Metadata: &data.Metadata{Geometry: toGeometry(vehiclePoints, stationPoints)},
+	}
+}
+
+func toGeometry(vehiclePoints, stationPoints []Point) *data.Geometry {
+	toDataPoints := func(points []Point) []data.Point {
+		dataPoints := make([]data.Point, len(points))
+		for i, p := range points {
+			dataPoints[i] = data.Point{X: p.X, Y: p.Y}
 		}
+		return dataPoints
 	}
 
-	return itemSizes
+	return &data.Geometry{Vehicles: toDataPoints(vehiclePoints), Stations: toDataPoints(stationPoints)}
 }
 
-func generateStationPoints(n int) []point {
+// computeR returns sizes of the item of a vehicle at the given point with the given demand in all buckets.
+func (g *Generator) computeR(vehiclePoint Point, stationPoints []Point, demand float64, radio *RadioModel) []int {
+	receivedPowers := make([]float64, len(stationPoints))
+	for j, stationPoint := range stationPoints {
+		receivedPowers[j] = radio.receivedPower(vehiclePoint.Distance(stationPoint), g.randomShadowing(radio))
+	}
+
+	sizes := make([]int, len(stationPoints))
+	for j := range sizes {
+		sizes[j] = radio.resourceBlocks(radio.signalQuality(receivedPowers, j), demand)
+	}
+	return sizes
+}
+
+func (g *Generator) generateStationPoints(n int) []Point {
 	stationsCountInOneDimension := int(math.Ceil(math.Sqrt(float64(n))))
 	radius := squareSideLength / float64(2*stationsCountInOneDimension)
 	totalStationCount := int(math.Pow(float64(stationsCountInOneDimension), 2))
 
-	stationIndices := random.Perm(totalStationCount)[0:n]
+	stationIndices := g.random.Perm(totalStationCount)[0:n]
 
-	stationPoints := make([]point, len(stationIndices))
+	stationPoints := make([]Point, len(stationIndices))
 	for i, index := range stationIndices {
 		stationPoints[i] = toStationPoint(radius, stationsCountInOneDimension, index)
 	}
@@ -57,64 +116,36 @@ func generateStationPoints(n int) []point {
 	return stationPoints
 }
 
-func generateVehiclePoints(v int) []point {
-	vehiclePoints := make([]point, v)
+func (g *Generator) generateVehiclePoints(v int) []Point {
+	vehiclePoints := make([]Point, v)
 	for i := range vehiclePoints {
-		x := random.Float64() * squareSideLength
-		y := random.Float64() * squareSideLength
-		vehiclePoints[i] = point{X: x, Y: y}
+		x := g.random.Float64() * squareSideLength
+		y := g.random.Float64() * squareSideLength
+		vehiclePoints[i] = Point{X: x, Y: y}
 	}
 
 	return vehiclePoints
 }
 
-func toStationPoint(radius float64, stationCount, i int) point {
+func toStationPoint(radius float64, stationCount, i int) Point {
 	x := radius * float64((1+2*i)%(2*stationCount))
 	y := radius + 2*(math.Floor(float64(i)/float64(stationCount)))*radius
-	return point{X: x, Y: y}
+	return Point{X: x, Y: y}
 }
 
-func computeR(p1, p2 point) int {
-	d := p1.Distance(p2)
-	s := bigP * math.Pow(d, -alpha)
-
-	return int(math.Ceil(rate / toRBDataRate(s)))
-}
-
-func toRBDataRate(s float64) float64 {
-	switch {
-	case s <= 0.4:
-		return 101.07
-	case s <= 2.5:
-		return 147.34
-	case s <= 4.5:
-		return 197.53
-	case s <= 6.5:
-		return 248.07
-	case s <= 8.5:
-		return 321.57
-	case s <= 10.3:
-		return 404.26
-	case s <= 12.3:
-		return 458.72
-	case s <= 14.2:
-		return 558.15
-	case s <= 15.9:
-		return 655.59
-	case s <= 17.8:
-		return 759.93
-	case s <= 19.8:
-		return 859.35
-	default:
-		return 933.19
-	}
-}
-
-type point struct {
+// Point is a point on the plane, where the square of generated vehicles and stations
+// spans from (0, 0) to (1, 1).
+type Point struct {
 	X float64
 	Y float64
 }
 
-func (p point) Distance(d point) float64 {
+// Distance returns the euclidean distance between points.
+func (p Point) Distance(d Point) float64 {
 	return math.Sqrt(math.Pow(p.X-d.X, 2) + math.Pow(p.Y-d.Y, 2))
 }
+
+func isInsideSquare(p Point) bool {
+	return p.X >= -positionTolerance && p.X <= squareSideLength+positionTolerance &&
+		p.Y >= -positionTolerance && p.Y <= squareSideLength+positionTolerance
+}
@@ -3,6 +3,7 @@ package generator
 import (
 	"testing"
 
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +15,7 @@ func TestGenerateV2XEnvironmental(t *testing.T) {
 	bucketCount := 5
 	maxBucketSize := 100
 
-	expectedMaxItemSize := rate / toRBDataRate(0)
+	expectedMaxItemSize := defaultDemand / DefaultMCSTable().DataRate(0)
 
 	result := GenerateV2XEnvironmental(itemCount, maxItemSize, bucketCount, maxBucketSize)
 
@@ -46,7 +47,7 @@ func TestGenerateV2XEnvironmentalConstantBucketSize(t *testing.T) {
 	bucketCount := 5
 	bucketSize := 100
 
-	expectedMaxItemSize := rate / toRBDataRate(0)
+	expectedMaxItemSize := defaultDemand / DefaultMCSTable().DataRate(0)
 
 	result := GenerateV2XEnvironmentalConstantBucketSize(itemCount, maxItemSize, bucketCount, bucketSize)
 
@@ -69,12 +70,45 @@ func TestGenerateV2XEnvironmentalConstantBucketSize(t *testing.T) {
 	}
 }
 
+func TestGenerator_GenerateV2X_metadata(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store geometry in metadata", func(t *testing.T) {
+		t.Parallel()
+
+		config := V2XConfig{VehicleCount: 10, BucketCount: 5, BucketSize: 100}
+
+		result := NewWithSeed(1).GenerateV2X(config)
+
+		assert.NotNil(t, result.Metadata)
+		assert.NotNil(t, result.Metadata.Geometry)
+		assert.Len(t, result.Metadata.Geometry.Vehicles, config.VehicleCount)
+		assert.Len(t, result.Metadata.Geometry.Stations, config.BucketCount)
+
+		for _, p := range append(result.Metadata.Geometry.Vehicles, result.Metadata.Geometry.Stations...) {
+			assert.True(t, isInsideSquare(Point{X: p.X, Y: p.Y}))
+		}
+	})
+
+	t.Run("should store explicit stations in order of buckets", func(t *testing.T) {
+		t.Parallel()
+
+		stations := []Station{{Point{0.1, 0.2}, 3}, {Point{0.3, 0.4}, 4}}
+		config := V2XConfig{VehicleCount: 2, Layout: StationLayout{Kind: Explicit, Stations: stations}}
+
+		result := NewWithSeed(1).GenerateV2X(config)
+
+		assert.Equal(t, []int{3, 4}, result.MRB)
+		assert.Equal(t, []data.Point{{X: 0.1, Y: 0.2}, {X: 0.3, Y: 0.4}}, result.Metadata.Geometry.Stations)
+	})
+}
+
 func Test_point_Distance(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		p1 *point
-		p2 *point
+		p1 *Point
+		p2 *Point
 	}
 	tests := []struct {
 		name string
@@ -84,16 +118,16 @@ func Test_point_Distance(t *testing.T) {
 		{
 			"euclidean distance for two different points",
 			args{
-				&point{0, 0},
-				&point{3, 4},
+				&Point{0, 0},
+				&Point{3, 4},
 			},
 			5,
 		},
 		{
 			"euclidean distance between the same point",
 			args{
-				&point{1, 1},
-				&point{1, 1},
+				&Point{1, 1},
+				&Point{1, 1},
 			},
 			0,
 		},
@@ -0,0 +1,190 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidVehicleDistribution is returned if VehicleDistribution cannot be used to place vehicles.
+var ErrInvalidVehicleDistribution = errors.New("invalid vehicle distribution")
+
+// VehicleDistributionKind defines how vehicles are placed within the square.
+type VehicleDistributionKind int
+
+const (
+	// UniformVehicles places vehicles uniformly and independently within the square.
+	UniformVehicles VehicleDistributionKind = iota
+	// ThomasCluster places vehicles around ClusterCount hotspots placed uniformly within the square.
+	// Each vehicle belongs to a random hotspot and its offset from the hotspot is drawn from
+	// the normal distribution with standard deviation of ClusterSpread in both dimensions.
+	ThomasCluster
+	// MaternCluster places vehicles around ClusterCount hotspots placed uniformly within the square.
+	// Each vehicle belongs to a random hotspot and it is placed uniformly within the disc
+	// of ClusterSpread radius centered at the hotspot.
+	MaternCluster
+	// RoadNetwork places vehicles uniformly along Roads, i.e. the density of vehicles is the same
+	// along each road, within the lane of RoadWidth width.
+	RoadNetwork
+	// TrafficJam places JamShare of vehicles evenly along JamSegment within the lane of RoadWidth width,
+	// as if they were queued, and the rest of them uniformly within the square.
+	TrafficJam
+)
+
+// VehicleDistribution defines placement of vehicles. Parameters are used only by kinds that refer to them.
+// Vehicles of cluster processes are placed within the square only, so hotspots close to its sides
+// have fewer vehicles around them. If Roads are empty, the road crossing the middle of the square
+// horizontally, i.e. the one of Highway, is used.
+type VehicleDistribution struct {
+	Kind          VehicleDistributionKind
+	ClusterCount  int
+	ClusterSpread float64
+	Roads         [][]Point
+	RoadWidth     float64
+	JamSegment    [2]Point
+	JamShare      float64
+}
+
+// Validate returns ErrInvalidVehicleDistribution if vehicles cannot be placed according to the distribution.
+func (d VehicleDistribution) Validate() error {
+	switch d.Kind {
+	case UniformVehicles:
+		return nil
+	case ThomasCluster, MaternCluster:
+		if d.ClusterCount <= 0 {
+			return fmt.Errorf("%w: cluster count has to be positive", ErrInvalidVehicleDistribution)
+		}
+		if d.ClusterSpread <= 0 {
+			return fmt.Errorf("%w: cluster spread has to be positive", ErrInvalidVehicleDistribution)
+		}
+		return nil
+	case RoadNetwork:
+		for i, road := range d.Roads {
+			if polylineLength(road) <= 0 {
+				return fmt.Errorf("%w: road %d has to consist of at least two distinct points",
+					ErrInvalidVehicleDistribution, i)
+			}
+		}
+		return d.validateRoadWidth()
+	case TrafficJam:
+		if d.JamSegment[0] == d.JamSegment[1] {
+			return fmt.Errorf("%w: jam segment has to consist of two distinct points", ErrInvalidVehicleDistribution)
+		}
+		if d.JamShare < 0 || d.JamShare > 1 {
+			return fmt.Errorf("%w: jam share has to be in range [0, 1]", ErrInvalidVehicleDistribution)
+		}
+		return d.validateRoadWidth()
+	default:
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidVehicleDistribution, d.Kind)
+	}
+}
+
+func (d VehicleDistribution) validateRoadWidth() error {
+	if d.RoadWidth < 0 {
+		return fmt.Errorf("%w: road width cannot be negative", ErrInvalidVehicleDistribution)
+	}
+	return nil
+}
+
+// placeVehicles returns points of n vehicles placed according to the distribution.
+func (g *Generator) placeVehicles(distribution VehicleDistribution, n int) []Point {
+	switch distribution.Kind {
+	case ThomasCluster, MaternCluster:
+		return g.generateClusteredVehiclePoints(distribution, n)
+	case RoadNetwork:
+		roads := distribution.Roads
+		if len(roads) == 0 {
+			roads = [][]Point{{{X: 0, Y: squareSideLength / 2.0}, {X: squareSideLength, Y: squareSideLength / 2.0}}}
+		}
+		return g.generateRoadVehiclePoints(roads, distribution.RoadWidth, n)
+	case TrafficJam:
+		return g.generateTrafficJamVehiclePoints(distribution, n)
+	default:
+		return g.generateVehiclePoints(n)
+	}
+}
+
+func (g *Generator) generateClusteredVehiclePoints(distribution VehicleDistribution, n int) []Point {
+	hotspots := make([]Point, distribution.ClusterCount)
+	for i := range hotspots {
+		hotspots[i] = g.randomPoint()
+	}
+
+	vehiclePoints := make([]Point, n)
+	for i := range vehiclePoints {
+		hotspot := hotspots[g.random.Intn(len(hotspots))]
+
+		for {
+			offset := g.clusterOffset(distribution)
+			vehiclePoints[i] = Point{X: hotspot.X + offset.X, Y: hotspot.Y + offset.Y}
+			if isInsideSquare(vehiclePoints[i]) {
+				break
+			}
+		}
+	}
+
+	return vehiclePoints
+}
+
+func (g *Generator) clusterOffset(distribution VehicleDistribution) Point {
+	if distribution.Kind == ThomasCluster {
+		return Point{
+			X: g.random.NormFloat64() * distribution.ClusterSpread,
+			Y: g.random.NormFloat64() * distribution.ClusterSpread,
+		}
+	}
+
+	radius := distribution.ClusterSpread * math.Sqrt(g.random.Float64())
+	angle := 2 * math.Pi * g.random.Float64()
+	return Point{X: radius * math.Cos(angle), Y: radius * math.Sin(angle)}
+}
+
+func (g *Generator) generateRoadVehiclePoints(roads [][]Point, width float64, n int) []Point {
+	lengths := make([]float64, len(roads))
+	var totalLength float64
+	for i, road := range roads {
+		lengths[i] = polylineLength(road)
+		totalLength += lengths[i]
+	}
+
+	vehiclePoints := make([]Point, n)
+	for i := range vehiclePoints {
+		distance := g.random.Float64() * totalLength
+
+		road := roads[len(roads)-1]
+		for j, length := range lengths {
+			if distance <= length {
+				road = roads[j]
+				break
+			}
+			distance -= length
+		}
+
+		vehiclePoints[i] = g.pointWithinLane(road, math.Min(distance, polylineLength(road)), width)
+	}
+
+	return vehiclePoints
+}
+
+func (g *Generator) generateTrafficJamVehiclePoints(distribution VehicleDistribution, n int) []Point {
+	segment := distribution.JamSegment[:]
+	length := polylineLength(segment)
+	jamCount := int(math.Round(distribution.JamShare * float64(n)))
+
+	vehiclePoints := make([]Point, 0, n)
+	for i := 0; i < jamCount; i++ {
+		distance := (float64(i) + 0.5) * length / float64(jamCount)
+		vehiclePoints = append(vehiclePoints, g.pointWithinLane(segment, distance, distribution.RoadWidth))
+	}
+
+	return append(vehiclePoints, g.generateVehiclePoints(n-jamCount)...)
+}
+
+// pointWithinLane returns the point at the given distance along the road shifted randomly
+// to the side of the road by at most half of the width of the lane.
+func (g *Generator) pointWithinLane(road []Point, distance, width float64) Point {
+	position, direction := pointOnPolyline(road, distance)
+	shift := (g.random.Float64() - 0.5) * width
+
+	return clampToSquare(Point{X: position.X - direction.Y*shift, Y: position.Y + direction.X*shift})
+}
@@ -0,0 +1,173 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVehicleDistribution_Validate(t *testing.T) {
+	t.Parallel()
+
+	segment := [2]Point{{0, 0}, {1, 0}}
+	thomas := VehicleDistribution{Kind: ThomasCluster, ClusterCount: 1, ClusterSpread: 0.1}
+	matern := VehicleDistribution{Kind: MaternCluster, ClusterCount: 1, ClusterSpread: 0.1}
+	jam := VehicleDistribution{Kind: TrafficJam, JamSegment: segment, JamShare: 1}
+
+	tests := []struct {
+		name         string
+		distribution VehicleDistribution
+		wantErr      bool
+	}{
+		{"should accept uniform distribution", VehicleDistribution{}, false},
+		{"should accept Thomas cluster", thomas, false},
+		{"should accept Matern cluster", matern, false},
+		{"should reject non-positive cluster count", VehicleDistribution{Kind: ThomasCluster, ClusterSpread: 0.1}, true},
+		{"should reject non-positive cluster spread", VehicleDistribution{Kind: MaternCluster, ClusterCount: 1}, true},
+		{"should accept road network with default road", VehicleDistribution{Kind: RoadNetwork}, false},
+		{"should reject road of single point", VehicleDistribution{Kind: RoadNetwork, Roads: [][]Point{{{0, 0}}}}, true},
+		{"should reject negative road width", VehicleDistribution{Kind: RoadNetwork, RoadWidth: -1}, true},
+		{"should accept traffic jam", jam, false},
+		{"should reject jam segment of the same points", VehicleDistribution{Kind: TrafficJam, JamShare: 0.5}, true},
+		{"should reject jam share out of range", VehicleDistribution{Kind: TrafficJam, JamSegment: segment, JamShare: 2},
+			true},
+		{"should reject unknown kind", VehicleDistribution{Kind: VehicleDistributionKind(100)}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.distribution.Validate()
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidVehicleDistribution)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerator_placeVehicles(t *testing.T) {
+	t.Parallel()
+
+	for name, distribution := range map[string]VehicleDistribution{
+		"uniform":        {},
+		"Thomas cluster": {Kind: ThomasCluster, ClusterCount: 3, ClusterSpread: 0.2},
+		"Matern cluster": {Kind: MaternCluster, ClusterCount: 3, ClusterSpread: 0.2},
+		"road network":   {Kind: RoadNetwork, RoadWidth: 0.05},
+		"traffic jam":    {Kind: TrafficJam, JamSegment: [2]Point{{0, 0}, {1, 1}}, JamShare: 0.5, RoadWidth: 0.05},
+	} {
+		distribution := distribution
+
+		t.Run("should place vehicles within square - "+name, func(t *testing.T) {
+			t.Parallel()
+
+			vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 200)
+
+			assert.Len(t, vehiclePoints, 200)
+			for _, p := range vehiclePoints {
+				assertInsideSquare(t, p)
+			}
+		})
+	}
+
+	t.Run("should place vehicles of Matern cluster within discs around hotspots", func(t *testing.T) {
+		t.Parallel()
+
+		distribution := VehicleDistribution{Kind: MaternCluster, ClusterCount: 2, ClusterSpread: 0.05}
+
+		g := NewWithSeed(1)
+		hotspots := []Point{g.randomPoint(), g.randomPoint()}
+		vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 100)
+
+		for _, p := range vehiclePoints {
+			assert.True(t, p.Distance(hotspots[0]) <= 0.05 || p.Distance(hotspots[1]) <= 0.05)
+		}
+	})
+
+	t.Run("should place vehicles of Thomas cluster close to hotspots", func(t *testing.T) {
+		t.Parallel()
+
+		distribution := VehicleDistribution{Kind: ThomasCluster, ClusterCount: 1, ClusterSpread: 0.01}
+
+		g := NewWithSeed(1)
+		hotspot := g.randomPoint()
+		vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 100)
+
+		for _, p := range vehiclePoints {
+			assert.Less(t, p.Distance(hotspot), 0.1)
+		}
+	})
+
+	t.Run("should place vehicles along roads proportionally to their lengths", func(t *testing.T) {
+		t.Parallel()
+
+		roads := [][]Point{{{0, 0.25}, {1, 0.25}}, {{0.5, 0.5}, {0.5, 0.75}}}
+		distribution := VehicleDistribution{Kind: RoadNetwork, Roads: roads}
+
+		vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 1000)
+
+		var onFirstRoad int
+		for _, p := range vehiclePoints {
+			if p.Y == 0.25 {
+				onFirstRoad++
+			} else {
+				assert.Equal(t, 0.5, p.X)
+				assert.GreaterOrEqual(t, p.Y, 0.5)
+				assert.LessOrEqual(t, p.Y, 0.75)
+			}
+		}
+
+		assert.InDelta(t, 800, onFirstRoad, 50)
+	})
+
+	t.Run("should place vehicles within lane", func(t *testing.T) {
+		t.Parallel()
+
+		distribution := VehicleDistribution{Kind: RoadNetwork, RoadWidth: 0.1}
+
+		vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 100)
+
+		for _, p := range vehiclePoints {
+			assert.InDelta(t, 0.5, p.Y, 0.05)
+		}
+	})
+
+	t.Run("should queue vehicles of traffic jam evenly along segment", func(t *testing.T) {
+		t.Parallel()
+
+		distribution := VehicleDistribution{Kind: TrafficJam, JamSegment: [2]Point{{0.2, 0.5}, {0.6, 0.5}}, JamShare: 0.4}
+
+		vehiclePoints := NewWithSeed(1).placeVehicles(distribution, 10)
+
+		assert.Len(t, vehiclePoints, 10)
+		for i, p := range vehiclePoints[:4] {
+			assert.InDelta(t, 0.25+0.1*float64(i), p.X, positionTolerance)
+			assert.Equal(t, 0.5, p.Y)
+		}
+	})
+}
+
+func TestGenerator_GenerateV2X_vehicles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate the same sizes for vehicles of the same point", func(t *testing.T) {
+		t.Parallel()
+
+		config := V2XConfig{
+			VehicleCount: 10,
+			BucketCount:  9,
+			BucketSize:   100,
+			Vehicles:     VehicleDistribution{Kind: TrafficJam, JamSegment: [2]Point{{0, 0}, {1e-12, 0}}, JamShare: 1},
+		}
+
+		result := NewWithSeed(1).GenerateV2X(config)
+
+		assert.Len(t, result.R, 10)
+		for _, sizes := range result.R {
+			assert.Equal(t, result.R[0], sizes)
+		}
+	})
+}
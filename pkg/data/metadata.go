@@ -0,0 +1,24 @@
+package data
+
+import "encoding/json"
+
+// Metadata describes how Data were generated. It is optional, it is not used by optimizers
+// and only formats that are able to store it preserve it.
+type Metadata struct {
+	Geometry *Geometry `json:"geometry,omitempty"`
+	// Parameters are parameters of the generation in the form defined by the generating tool.
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// Geometry stores positions of vehicles and stations. Positions of vehicles are ordered as rows of R
+// and positions of stations are ordered as elements of MRB.
+type Geometry struct {
+	Vehicles []Point `json:"vehicles"`
+	Stations []Point `json:"stations"`
+}
+
+// Point is a position on the plane.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Snapshot is Data at a single step of Scenario. VehicleIDs identify vehicles over time,
+// i.e. VehicleIDs[i] is the ID of the vehicle whose costs are defined by R[i].
+type Snapshot struct {
+	Data
+	VehicleIDs []int
+}
+
+// Scenario is a sequence of snapshots of the same RRHs with vehicles entering, moving and leaving over time.
+// A vehicle enters at the first snapshot containing its ID and leaves after the last one.
+type Scenario struct {
+	Snapshots []Snapshot
+	// Parameters are optional parameters of the generation in the form defined by the generating tool.
+	Parameters json.RawMessage `json:",omitempty"`
+}
+
+// Validate verifies that all snapshots define the same number of RRHs, that each vehicle of a snapshot
+// has its ID and that IDs are unique within each snapshot. It returns an error wrapping ErrMalformedData otherwise.
+func (s *Scenario) Validate() error {
+	for i, snapshot := range s.Snapshots {
+		if len(snapshot.MRB) != len(s.Snapshots[0].MRB) {
+			return fmt.Errorf("%w: snapshot %d defines %d RRHs instead of %d",
+				ErrMalformedData, i, len(snapshot.MRB), len(s.Snapshots[0].MRB))
+		}
+
+		if len(snapshot.VehicleIDs) != len(snapshot.R) {
+			return fmt.Errorf("%w: snapshot %d defines %d vehicle IDs for %d vehicles",
+				ErrMalformedData, i, len(snapshot.VehicleIDs), len(snapshot.R))
+		}
+
+		for _, costs := range snapshot.R {
+			if len(costs) != len(snapshot.MRB) {
+				return fmt.Errorf("%w: snapshot %d defines costs of a vehicle for %d RRHs instead of %d",
+					ErrMalformedData, i, len(costs), len(snapshot.MRB))
+			}
+		}
+
+		ids := make(map[int]struct{}, len(snapshot.VehicleIDs))
+		for _, id := range snapshot.VehicleIDs {
+			if _, ok := ids[id]; ok {
+				return fmt.Errorf("%w: snapshot %d contains vehicle %d several times", ErrMalformedData, i, id)
+			}
+			ids[id] = struct{}{}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenario_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should accept valid scenario", func(t *testing.T) {
+		t.Parallel()
+
+		s := &Scenario{Snapshots: []Snapshot{
+			{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2}}}, VehicleIDs: []int{7}},
+			{Data: Data{MRB: []int{5, 6}, R: [][]int{}}, VehicleIDs: []int{}},
+			{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2}, {3, 4}}}, VehicleIDs: []int{8, 7}},
+		}}
+
+		assert.NoError(t, s.Validate())
+		assert.NoError(t, (&Scenario{}).Validate())
+	})
+
+	t.Run("should reject malformed scenarios", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]*Scenario{
+			"different number of RRHs": {Snapshots: []Snapshot{
+				{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2}}}, VehicleIDs: []int{1}},
+				{Data: Data{MRB: []int{5}, R: [][]int{{1}}}, VehicleIDs: []int{1}},
+			}},
+			"missing vehicle ID": {Snapshots: []Snapshot{
+				{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2}, {3, 4}}}, VehicleIDs: []int{1}},
+			}},
+			"malformed costs": {Snapshots: []Snapshot{
+				{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2, 3}}}, VehicleIDs: []int{1}},
+			}},
+			"duplicated vehicle ID": {Snapshots: []Snapshot{
+				{Data: Data{MRB: []int{5, 5}, R: [][]int{{1, 2}, {3, 4}}}, VehicleIDs: []int{1, 1}},
+			}},
+		}
+
+		for name, s := range scenarios {
+			assert.ErrorIs(t, s.Validate(), ErrMalformedData, name)
+		}
+	})
+}
@@ -6,16 +6,29 @@ import (
 )
 
 // Data is a structure that represents data required to the computation of the solution.
+// Metadata are optional and they are not required to compute the solution.
 type Data struct {
-	MRB []int
-	R   [][]int
+	MRB      []int
+	R        [][]int
+	Metadata *Metadata `json:",omitempty"`
 }
 
-// EncoderDecoder represents object that is able to encode and decode Data structure.
-type EncoderDecoder interface {
+// Encoder represents object that is able to encode Data structure.
+// Formats implementing only Encoder are export-only, i.e. Data cannot be decoded from them.
+type Encoder interface {
 	Encode(*Data, io.Writer) error
+}
+
+// Decoder represents object that is able to decode Data structure.
+type Decoder interface {
 	Decode(io.Reader) (*Data, error)
 }
 
+// EncoderDecoder represents object that is able to encode and decode Data structure.
+type EncoderDecoder interface {
+	Encoder
+	Decoder
+}
+
 // ErrMalformedData is returned if some data in encoding/decoding are incorrect.
 var ErrMalformedData = errors.New("malformed data")
@@ -0,0 +1,92 @@
+package annealing
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// SimulatedAnnealing is an optimizer that improves the solution found by InitialOptimizer using simulated annealing.
+// In each iteration it moves a random item to another random bucket that is already in use and has enough space.
+// Moves that empty buckets are always accepted, while moves that worsen the solution are accepted
+// with the probability depending on the temperature defined by Schedule. The energy of a solution is the number
+// of used buckets decreased by the average squared fill ratio of buckets, so among solutions with the same number
+// of buckets, the ones with unevenly loaded buckets are preferred (see helper.Packing).
+// The process stops after Iterations iterations, after TimeLimit (if greater than zero) or when the context is done,
+// and returns the best solution found. If Source is nil, the source seeded with the current time is used
+// (see helper.NewLockedSource).
+type SimulatedAnnealing struct {
+	InitialOptimizer optimizer.Optimizer
+	Schedule         CoolingSchedule
+	Iterations       int
+	TimeLimit        time.Duration
+	Source           rand.Source
+}
+
+func (s SimulatedAnnealing) Optimize(ctx context.Context, data *data.Data) (*optimizer.Result, error) {
+	initialResult, err := s.InitialOptimizer.Optimize(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.TimeLimit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.TimeLimit)
+		defer cancel()
+	}
+
+	if len(data.R) == 0 {
+		return initialResult, nil
+	}
+
+	random := s.newRandom()
+	packing := helper.NewPacking(data, initialResult.VehiclesToRRHAssignment)
+
+	bestCount := packing.UsedCount()
+	bestAssignment := make([]int, len(data.R))
+	copy(bestAssignment, packing.Assignment())
+
+	for iteration := 0; iteration < s.Iterations; iteration++ {
+		select {
+		case <-ctx.Done():
+			return helper.ToResult(bestAssignment, len(data.MRB)), nil
+		default:
+		}
+
+		item := random.Intn(len(data.R))
+		usedBuckets := packing.UsedBuckets()
+		bucket := usedBuckets[random.Intn(len(usedBuckets))]
+
+		if !packing.CanMove(item, bucket) {
+			continue
+		}
+
+		delta := energyDelta(packing, item, bucket, data.R[item])
+		temperature := s.Schedule(iteration, s.Iterations)
+
+		if delta > 0 && (temperature <= 0 || random.Float64() >= math.Exp(-delta/temperature)) {
+			continue
+		}
+
+		packing.Move(item, bucket)
+
+		if packing.UsedCount() < bestCount {
+			bestCount = packing.UsedCount()
+			copy(bestAssignment, packing.Assignment())
+		}
+	}
+
+	return helper.ToResult(bestAssignment, len(data.MRB)), nil
+}
+
+func (s SimulatedAnnealing) newRandom() *rand.Rand {
+	if s.Source == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return rand.New(rand.NewSource(s.Source.Int63()))
+}
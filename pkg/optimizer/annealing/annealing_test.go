@@ -0,0 +1,142 @@
+package annealing
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/optimizertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatedAnnealing_Optimize(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 10, 10},
+		R: [][]int{
+			{6, 6, 6},
+			{2, 2, 2},
+			{3, 3, 3},
+			{5, 5, 5},
+			{4, 4, 4},
+		},
+	}
+
+	newAnnealing := func(seed int64) SimulatedAnnealing {
+		return SimulatedAnnealing{
+			InitialOptimizer: bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic},
+			Schedule:         GeometricCooling(1, 0.999),
+			Iterations:       10000,
+			Source:           rand.NewSource(seed),
+		}
+	}
+
+	t.Run("should improve initial solution", func(t *testing.T) {
+		t.Parallel()
+
+		initialResult, err := bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}.Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, initialResult.RRHCount)
+
+		result, err := newAnnealing(1).Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.RRHCount)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should return the same result for the same seed", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := newAnnealing(5).Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		second, err := newAnnealing(5).Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should never return solution worse than the initial one", func(t *testing.T) {
+		t.Parallel()
+
+		random := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 20; i++ {
+			instance := randomData(random, 12, 5, 10, 25)
+
+			initialResult, err := firstfit.FirstFit{}.Optimize(context.TODO(), instance)
+			if err != nil {
+				continue
+			}
+
+			sa := SimulatedAnnealing{
+				InitialOptimizer: firstfit.FirstFit{},
+				Schedule:         LinearCooling(0.5),
+				Iterations:       2000,
+				Source:           rand.NewSource(int64(i)),
+			}
+
+			result, err := sa.Optimize(context.TODO(), instance)
+
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, result.RRHCount, initialResult.RRHCount)
+			assert.NoError(t, optimizer.Validate(instance, result))
+		}
+	})
+
+	t.Run("should return error of initial optimizer", func(t *testing.T) {
+		t.Parallel()
+
+		infeasible := &data.Data{
+			MRB: []int{5, 5},
+			R:   [][]int{{4, 4}, {4, 4}, {4, 4}},
+		}
+
+		result, err := newAnnealing(1).Optimize(context.TODO(), infeasible)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return the best solution found if time limit is reached", func(t *testing.T) {
+		t.Parallel()
+
+		sa := newAnnealing(1)
+		sa.Iterations = int(^uint(0) >> 1)
+		sa.TimeLimit = 1
+
+		result, err := sa.Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should conform to optimizer contract", func(t *testing.T) {
+		t.Parallel()
+
+		optimizertest.TestConformance(t, newAnnealing(1))
+	})
+}
+
+func randomData(random *rand.Rand, v, n, maxItemSize, maxBucketSize int) *data.Data {
+	d := &data.Data{MRB: make([]int, n), R: make([][]int, v)}
+
+	for j := range d.MRB {
+		d.MRB[j] = random.Intn(maxBucketSize) + 1
+	}
+
+	for i := range d.R {
+		d.R[i] = make([]int, n)
+		for j := range d.R[i] {
+			d.R[i][j] = random.Intn(maxItemSize) + 1
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,21 @@
+package annealing
+
+import "github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+
+// energyDelta computes the change of the energy caused by moving the item to the bucket.
+func energyDelta(p *helper.Packing, item, bucket int, r []int) float64 {
+	source := p.Bucket(item)
+
+	newSourceLoad := p.Load(source) - r[source]
+	newBucketLoad := p.Load(bucket) + r[bucket]
+
+	squaredFillDelta := p.SquaredFill(source, newSourceLoad) - p.SquaredFill(source, p.Load(source)) +
+		p.SquaredFill(bucket, newBucketLoad) - p.SquaredFill(bucket, p.Load(bucket))
+
+	var countDelta float64
+	if p.ItemCount(source) == 1 {
+		countDelta = -1
+	}
+
+	return countDelta - squaredFillDelta/float64(len(r))
+}
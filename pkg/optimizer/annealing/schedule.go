@@ -0,0 +1,23 @@
+package annealing
+
+import "math"
+
+// CoolingSchedule defines the temperature of the annealing process in the given iteration.
+// Iterations are numbered from 0 to the total number of iterations minus one.
+type CoolingSchedule func(iteration, iterations int) float64
+
+// GeometricCooling returns CoolingSchedule that multiplies the temperature by rate in each iteration,
+// starting from the initial temperature. The rate should be in range (0, 1).
+func GeometricCooling(initialTemperature, rate float64) CoolingSchedule {
+	return func(iteration, _ int) float64 {
+		return initialTemperature * math.Pow(rate, float64(iteration))
+	}
+}
+
+// LinearCooling returns CoolingSchedule that decreases the temperature linearly
+// from the initial temperature to zero in the last iteration.
+func LinearCooling(initialTemperature float64) CoolingSchedule {
+	return func(iteration, iterations int) float64 {
+		return initialTemperature * float64(iterations-iteration) / float64(iterations)
+	}
+}
@@ -0,0 +1,27 @@
+package annealing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeometricCooling(t *testing.T) {
+	t.Parallel()
+
+	schedule := GeometricCooling(2, 0.5)
+
+	assert.Equal(t, 2.0, schedule(0, 10))
+	assert.Equal(t, 1.0, schedule(1, 10))
+	assert.Equal(t, 0.25, schedule(3, 10))
+}
+
+func TestLinearCooling(t *testing.T) {
+	t.Parallel()
+
+	schedule := LinearCooling(2)
+
+	assert.Equal(t, 2.0, schedule(0, 4))
+	assert.Equal(t, 1.0, schedule(2, 4))
+	assert.Equal(t, 0.5, schedule(3, 4))
+}
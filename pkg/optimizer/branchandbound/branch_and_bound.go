@@ -0,0 +1,212 @@
+package branchandbound
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// BranchAndBound is an exact optimizer that implements the depth-first branch-and-bound algorithm solving
+// the bin packing problem with heterogeneous bins and items with different sizes that depend on the bin choice.
+// It finds the provably minimal number of buckets, so it may be used instead of the CPLEX model
+// for small and medium instances. The search starts from the solution found by bestfit.BestFit and prunes
+// branches using the volume bound built on the smallest size of each item. Items are assigned in descending
+// order of their smallest size, and already open buckets are always tried before opening a new one.
+// If the context is cancelled before the search space is exhausted, the best solution found so far is returned.
+// The implementation works in exponential time in the worst case.
+type BranchAndBound struct{}
+
+func (b BranchAndBound) Optimize(ctx context.Context, data *data.Data) (*optimizer.Result, error) {
+	n := len(data.MRB)
+
+	s, err := newSearch(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	initialResult, err := bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}.Optimize(ctx, data)
+	switch {
+	case err == nil:
+		s.bestCount = initialResult.RRHCount
+		s.bestAssignment = initialResult.VehiclesToRRHAssignment
+	case !errors.Is(err, optimizer.ErrCannotAssignToBucket):
+		return nil, err
+	}
+
+	err = s.branch(0)
+
+	if s.bestAssignment == nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, optimizer.ErrCannotAssignToBucket
+	}
+
+	return helper.ToResult(s.bestAssignment, n), nil
+}
+
+type search struct {
+	ctx  context.Context
+	data *data.Data
+
+	itemOrder         []int
+	bucketOrder       []int
+	remainingMinSizes []int
+
+	leftSpace  []int
+	isOpen     []bool
+	openCount  int
+	assignment []int
+
+	bestCount      int
+	bestAssignment []int
+}
+
+func newSearch(ctx context.Context, data *data.Data) (*search, error) {
+	v := len(data.R)
+	n := len(data.MRB)
+
+	minSizes := make([]int, v)
+	for i := range data.R {
+		minSize := -1
+		for j, size := range data.R[i] {
+			if size <= data.MRB[j] && (minSize < 0 || size < minSize) {
+				minSize = size
+			}
+		}
+		if minSize < 0 {
+			return nil, optimizer.ErrCannotAssignToBucket
+		}
+		minSizes[i] = minSize
+	}
+
+	itemOrder := helper.NoOpReorder(minSizes)
+	sort.SliceStable(itemOrder, func(i, j int) bool {
+		return minSizes[itemOrder[i]] > minSizes[itemOrder[j]]
+	})
+
+	remainingMinSizes := make([]int, v+1)
+	for depth := v - 1; depth >= 0; depth-- {
+		remainingMinSizes[depth] = remainingMinSizes[depth+1] + minSizes[itemOrder[depth]]
+	}
+
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
+
+	return &search{
+		ctx:               ctx,
+		data:              data,
+		itemOrder:         itemOrder,
+		bucketOrder:       helper.DescendingBucketSizeReorder(data.MRB),
+		remainingMinSizes: remainingMinSizes,
+		leftSpace:         leftSpace,
+		isOpen:            make([]bool, n),
+		assignment:        make([]int, v),
+		bestCount:         n + 1,
+	}, nil
+}
+
+func (s *search) branch(depth int) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	default:
+	}
+
+	if depth == len(s.itemOrder) {
+		if s.openCount < s.bestCount {
+			s.bestCount = s.openCount
+			s.bestAssignment = make([]int, len(s.assignment))
+			copy(s.bestAssignment, s.assignment)
+		}
+		return nil
+	}
+
+	if s.lowerBound(depth) >= s.bestCount {
+		return nil
+	}
+
+	item := s.itemOrder[depth]
+
+	for _, bucket := range s.bucketOrder {
+		if !s.isOpen[bucket] || s.data.R[item][bucket] > s.leftSpace[bucket] {
+			continue
+		}
+
+		if err := s.assignAndBranch(depth, item, bucket); err != nil {
+			return err
+		}
+	}
+
+	if s.openCount+1 >= s.bestCount {
+		return nil
+	}
+
+	for _, bucket := range s.bucketOrder {
+		if s.isOpen[bucket] || s.data.R[item][bucket] > s.leftSpace[bucket] {
+			continue
+		}
+
+		s.isOpen[bucket] = true
+		s.openCount++
+
+		err := s.assignAndBranch(depth, item, bucket)
+
+		s.isOpen[bucket] = false
+		s.openCount--
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *search) assignAndBranch(depth, item, bucket int) error {
+	s.assignment[item] = bucket
+	s.leftSpace[bucket] -= s.data.R[item][bucket]
+	err := s.branch(depth + 1)
+	s.leftSpace[bucket] += s.data.R[item][bucket]
+	return err
+}
+
+// lowerBound computes the minimal number of buckets that have to be open to assign all remaining items.
+// Each item occupies at least its smallest size regardless of the bucket, so the remaining items require
+// at least as much space as the sum of their smallest sizes. The space is taken from open buckets first
+// and then from the biggest closed ones.
+func (s *search) lowerBound(depth int) int {
+	requiredSpace := s.remainingMinSizes[depth]
+
+	for bucket, isOpen := range s.isOpen {
+		if isOpen {
+			requiredSpace -= s.leftSpace[bucket]
+		}
+	}
+
+	bound := s.openCount
+
+	for _, bucket := range s.bucketOrder {
+		if requiredSpace <= 0 {
+			return bound
+		}
+
+		if s.isOpen[bucket] {
+			continue
+		}
+
+		requiredSpace -= s.leftSpace[bucket]
+		bound++
+	}
+
+	if requiredSpace > 0 {
+		return len(s.isOpen) + 1
+	}
+
+	return bound
+}
@@ -0,0 +1,226 @@
+package branchandbound
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchAndBound_Optimize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should find optimal solution which is better than the initial one", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{
+			MRB: []int{10, 10, 10},
+			R: [][]int{
+				{6, 6, 6},
+				{2, 2, 2},
+				{3, 3, 3},
+				{5, 5, 5},
+				{4, 4, 4},
+			},
+		}
+
+		initialResult, err := bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}.Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, initialResult.RRHCount)
+
+		result, err := BranchAndBound{}.Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.RRHCount)
+		assert.Equal(t, []bool{true, true, false}, result.RRHEnable)
+		assert.Equal(t, []int{0, 1, 1, 1, 0}, result.VehiclesToRRHAssignment)
+	})
+
+	t.Run("should find the same number of buckets as exhaustive search", func(t *testing.T) {
+		t.Parallel()
+
+		random := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 50; i++ {
+			d := randomData(random, 7, 4, 10, 15)
+
+			result, err := BranchAndBound{}.Optimize(context.TODO(), d)
+			expectedCount := exhaustiveSearch(d)
+
+			if expectedCount < 0 {
+				assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+				assert.Nil(t, result)
+				continue
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedCount, result.RRHCount)
+			assertFeasible(t, d, result)
+		}
+	})
+
+	t.Run("should return error if there is no possibility to pack items", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{
+			MRB: []int{5, 5},
+			R: [][]int{
+				{4, 4},
+				{4, 4},
+				{4, 4},
+			},
+		}
+
+		result, err := BranchAndBound{}.Optimize(context.TODO(), d)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if item doesn't fit any bucket", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{
+			MRB: []int{5, 5},
+			R: [][]int{
+				{4, 4},
+				{6, 7},
+			},
+		}
+
+		result, err := BranchAndBound{}.Optimize(context.TODO(), d)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return context error if context is cancelled before any solution is found", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		d := &data.Data{
+			MRB: []int{10, 10},
+			R: [][]int{
+				{6, 6},
+				{5, 5},
+			},
+		}
+
+		result, err := BranchAndBound{}.Optimize(ctx, d)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return the best solution found so far if context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		d := randomData(rand.New(rand.NewSource(2)), 60, 20, 10, 30)
+
+		initialResult, err := bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}.Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		ctx := &cancelAfterContext{Context: context.TODO(), doneCallsLeft: int64(len(d.R)*len(d.MRB) + 100)}
+
+		result, err := BranchAndBound{}.Optimize(ctx, d)
+
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, result.RRHCount, initialResult.RRHCount)
+		assertFeasible(t, d, result)
+	})
+}
+
+// cancelAfterContext is a context that becomes cancelled after the given number of calls to Done.
+type cancelAfterContext struct {
+	context.Context
+	doneCallsLeft int64
+}
+
+func (c *cancelAfterContext) Done() <-chan struct{} {
+	if atomic.AddInt64(&c.doneCallsLeft, -1) < 0 {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	}
+	return nil
+}
+
+func (c *cancelAfterContext) Err() error {
+	if atomic.LoadInt64(&c.doneCallsLeft) < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func (c *cancelAfterContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func randomData(random *rand.Rand, v, n, maxItemSize, maxBucketSize int) *data.Data {
+	d := &data.Data{MRB: make([]int, n), R: make([][]int, v)}
+	for j := range d.MRB {
+		d.MRB[j] = random.Intn(maxBucketSize) + 1
+	}
+	for i := range d.R {
+		d.R[i] = make([]int, n)
+		for j := range d.R[i] {
+			d.R[i][j] = random.Intn(maxItemSize) + 1
+		}
+	}
+	return d
+}
+
+func exhaustiveSearch(d *data.Data) int {
+	best := -1
+	assignment := make([]int, len(d.R))
+
+	var search func(item int)
+	search = func(item int) {
+		if item == len(d.R) {
+			leftSpace := make([]int, len(d.MRB))
+			copy(leftSpace, d.MRB)
+			used := make(map[int]struct{})
+			for i, bucket := range assignment {
+				leftSpace[bucket] -= d.R[i][bucket]
+				if leftSpace[bucket] < 0 {
+					return
+				}
+				used[bucket] = struct{}{}
+			}
+			if best < 0 || len(used) < best {
+				best = len(used)
+			}
+			return
+		}
+
+		for bucket := range d.MRB {
+			assignment[item] = bucket
+			search(item + 1)
+		}
+	}
+
+	search(0)
+	return best
+}
+
+func assertFeasible(t *testing.T, d *data.Data, result *optimizer.Result) {
+	leftSpace := make([]int, len(d.MRB))
+	copy(leftSpace, d.MRB)
+
+	for i, bucket := range result.VehiclesToRRHAssignment {
+		assert.True(t, result.RRHEnable[bucket])
+		leftSpace[bucket] -= d.R[i][bucket]
+	}
+
+	for _, space := range leftSpace {
+		assert.GreaterOrEqual(t, space, 0)
+	}
+}
@@ -17,7 +17,8 @@ func (f FirstFit) Optimize(ctx context.Context, data *data.Data) (*optimizer.Res
 	v := len(data.R)
 	n := len(data.MRB)
 	sequence := make([]int, v)
-	leftSpace := data.MRB
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
 
 	for i := 0; i < v; i++ {
 		j := 0
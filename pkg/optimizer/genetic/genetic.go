@@ -0,0 +1,203 @@
+package genetic
+
+import (
+	"context"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// Genetic is an optimizer that implements the grouping genetic algorithm. Individuals are assignments of items
+// to buckets and are created by assigning items in a random order to the best fitting used bucket or to a random
+// unused one. Offspring inherit whole groups of items (i.e. contents of buckets) from the second parent
+// and the remaining groups from the first parent. Items that were left unassigned due to inherited groups
+// are reassigned with respect to MRB of buckets, starting from the biggest one. With MutationRate probability
+// a random used bucket of the offspring is dissolved and its items are reassigned in the same way.
+// Parents are chosen by binary tournament and the best individual always survives to the next generation.
+// Individuals are compared by the number of used buckets first, and then by the sum of squared ratios of occupied
+// space to the size of a bucket (see helper.Packing).
+// Offspring are created and evaluated in parallel by Workers goroutines (runtime.NumCPU if not greater than zero),
+// yet the result depends only on Source. The process stops after Generations generations, after TimeLimit
+// (if greater than zero) or when the context is done, and returns the best individual found.
+// If Source is nil, the source seeded with the current time is used (see helper.NewLockedSource).
+type Genetic struct {
+	PopulationSize int
+	Generations    int
+	MutationRate   float64
+	Workers        int
+	TimeLimit      time.Duration
+	Source         rand.Source
+}
+
+func (g Genetic) Optimize(ctx context.Context, data *data.Data) (*optimizer.Result, error) {
+	if g.TimeLimit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.TimeLimit)
+		defer cancel()
+	}
+
+	if len(data.R) == 0 {
+		return helper.ToResult([]int{}, len(data.MRB)), nil
+	}
+
+	random := g.newRandom()
+
+	population, err := g.initialPopulation(data, random)
+	if err != nil {
+		return nil, err
+	}
+
+	best := bestIndividual(population)
+
+	for generation := 0; generation < g.Generations; generation++ {
+		select {
+		case <-ctx.Done():
+			return helper.ToResult(best.assignment, len(data.MRB)), nil
+		default:
+		}
+
+		population = g.nextPopulation(data, population, best, random)
+		best = bestIndividual(population)
+	}
+
+	return helper.ToResult(best.assignment, len(data.MRB)), nil
+}
+
+func (g Genetic) initialPopulation(data *data.Data, random *rand.Rand) ([]*individual, error) {
+	population := make([]*individual, g.populationSize())
+
+	g.inParallel(len(population), random, func(i int, random *rand.Rand) {
+		assignment := make([]int, len(data.R))
+		for item := range assignment {
+			assignment[item] = unassigned
+		}
+
+		if err := repair(data, assignment, true, random); err == nil {
+			population[i] = newIndividual(data, assignment)
+		}
+	})
+
+	var feasible []*individual
+	for _, ind := range population {
+		if ind != nil {
+			feasible = append(feasible, ind)
+		}
+	}
+
+	if len(feasible) == 0 {
+		return nil, optimizer.ErrCannotAssignToBucket
+	}
+
+	for i := range population {
+		if population[i] == nil {
+			population[i] = feasible[i%len(feasible)]
+		}
+	}
+
+	return population, nil
+}
+
+func (g Genetic) nextPopulation(
+	data *data.Data,
+	population []*individual,
+	best *individual,
+	random *rand.Rand,
+) []*individual {
+	next := make([]*individual, len(population))
+	next[0] = best
+
+	g.inParallel(len(next)-1, random, func(i int, random *rand.Rand) {
+		first := tournament(population, random)
+		second := tournament(population, random)
+
+		child := crossover(first, second, random)
+
+		if random.Float64() < g.MutationRate {
+			mutate(child, random)
+		}
+
+		if err := repair(data, child, false, random); err != nil {
+			next[i+1] = first
+			return
+		}
+
+		next[i+1] = newIndividual(data, child)
+	})
+
+	return next
+}
+
+// inParallel calls fn for each index in range [0, count) using Workers goroutines. Each call gets its own random
+// generator seeded from the given one in the order of indexes, so results don't depend on scheduling of goroutines.
+func (g Genetic) inParallel(count int, random *rand.Rand, fn func(i int, random *rand.Rand)) {
+	seeds := make([]int64, count)
+	for i := range seeds {
+		seeds[i] = random.Int63()
+	}
+
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < g.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i, rand.New(rand.NewSource(seeds[i])))
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+}
+
+func (g Genetic) populationSize() int {
+	if g.PopulationSize < 1 {
+		return 1
+	}
+	return g.PopulationSize
+}
+
+func (g Genetic) workers() int {
+	if g.Workers > 0 {
+		return g.Workers
+	}
+	return runtime.NumCPU()
+}
+
+func (g Genetic) newRandom() *rand.Rand {
+	if g.Source == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return rand.New(rand.NewSource(g.Source.Int63()))
+}
+
+func tournament(population []*individual, random *rand.Rand) *individual {
+	first := population[random.Intn(len(population))]
+	second := population[random.Intn(len(population))]
+
+	if second.fitness.isBetter(first.fitness) {
+		return second
+	}
+	return first
+}
+
+func bestIndividual(population []*individual) *individual {
+	best := population[0]
+	for _, ind := range population[1:] {
+		if ind.fitness.isBetter(best.fitness) {
+			best = ind
+		}
+	}
+	return best
+}
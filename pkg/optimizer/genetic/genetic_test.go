@@ -0,0 +1,139 @@
+package genetic
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/optimizertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenetic_Optimize(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 10, 10},
+		R: [][]int{
+			{6, 6, 6},
+			{2, 2, 2},
+			{3, 3, 3},
+			{5, 5, 5},
+			{4, 4, 4},
+		},
+	}
+
+	newGenetic := func(seed int64, workers int) Genetic {
+		return Genetic{
+			PopulationSize: 10,
+			Generations:    50,
+			MutationRate:   0.2,
+			Workers:        workers,
+			Source:         rand.NewSource(seed),
+		}
+	}
+
+	t.Run("should find optimal solution", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := newGenetic(1, 2).Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.RRHCount)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should return the same result for the same seed regardless of number of workers", func(t *testing.T) {
+		t.Parallel()
+
+		random := rand.New(rand.NewSource(1))
+		instance := randomData(random, 30, 8, 10, 40)
+
+		first, err := newGenetic(5, 1).Optimize(context.TODO(), instance)
+		assert.NoError(t, err)
+
+		second, err := newGenetic(5, 4).Optimize(context.TODO(), instance)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should return feasible solutions of random instances", func(t *testing.T) {
+		t.Parallel()
+
+		random := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 20; i++ {
+			instance := randomData(random, 12, 5, 10, 25)
+
+			result, err := newGenetic(int64(i), 0).Optimize(context.TODO(), instance)
+			if err != nil {
+				assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+				continue
+			}
+
+			assert.NoError(t, optimizer.Validate(instance, result))
+		}
+	})
+
+	t.Run("should return error if items cannot be assigned", func(t *testing.T) {
+		t.Parallel()
+
+		infeasible := &data.Data{
+			MRB: []int{5, 5},
+			R:   [][]int{{4, 4}, {4, 4}, {4, 4}},
+		}
+
+		result, err := newGenetic(1, 2).Optimize(context.TODO(), infeasible)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return empty result if there are no vehicles", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := newGenetic(1, 2).Optimize(context.TODO(), &data.Data{MRB: []int{5, 5}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &optimizer.Result{RRHEnable: []bool{false, false}, VehiclesToRRHAssignment: []int{}}, result)
+	})
+
+	t.Run("should return the best solution found if time limit is reached", func(t *testing.T) {
+		t.Parallel()
+
+		g := newGenetic(1, 2)
+		g.Generations = int(^uint(0) >> 1)
+		g.TimeLimit = 1
+
+		result, err := g.Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should conform to optimizer contract", func(t *testing.T) {
+		t.Parallel()
+
+		optimizertest.TestConformance(t, newGenetic(1, 2))
+	})
+}
+
+func randomData(random *rand.Rand, v, n, maxItemSize, maxBucketSize int) *data.Data {
+	d := &data.Data{MRB: make([]int, n), R: make([][]int, v)}
+
+	for j := range d.MRB {
+		d.MRB[j] = random.Intn(maxBucketSize) + 1
+	}
+
+	for i := range d.R {
+		d.R[i] = make([]int, n)
+		for j := range d.R[i] {
+			d.R[i][j] = random.Intn(maxItemSize) + 1
+		}
+	}
+
+	return d
+}
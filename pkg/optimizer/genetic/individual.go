@@ -0,0 +1,191 @@
+package genetic
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// unassigned marks items that are not assigned to any bucket in the course of constructing an individual.
+const unassigned = -1
+
+// fitness describes the quality of an individual. Individuals are compared by count first and by spread afterwards.
+// The spread is the sum of squared ratios of occupied space to the size of a bucket, so it is bigger for individuals
+// with lopsided load of buckets.
+type fitness struct {
+	count  int
+	spread float64
+}
+
+func (f fitness) isBetter(other fitness) bool {
+	if f.count != other.count {
+		return f.count < other.count
+	}
+	return f.spread > other.spread
+}
+
+type individual struct {
+	assignment []int
+	fitness    fitness
+}
+
+func newIndividual(data *data.Data, assignment []int) *individual {
+	packing := helper.NewPacking(data, assignment)
+
+	f := fitness{count: packing.UsedCount()}
+	for _, bucket := range packing.UsedBuckets() {
+		f.spread += packing.SquaredFill(bucket, packing.Load(bucket))
+	}
+
+	return &individual{assignment: assignment, fitness: f}
+}
+
+// crossover returns the assignment that inherits whole groups of items, i.e. the contents of randomly chosen buckets,
+// from the second parent and the rest from the first parent. Buckets of the first parent that contain any of
+// the inherited items are dissolved, so their remaining items and items of the first parent previously assigned to
+// the inherited buckets are left unassigned.
+func crossover(first, second *individual, random *rand.Rand) []int {
+	inherited := make(map[int]bool)
+	for _, bucket := range second.assignment {
+		if _, ok := inherited[bucket]; !ok {
+			inherited[bucket] = random.Intn(2) == 0
+		}
+	}
+
+	dissolved := make(map[int]bool)
+	for item, bucket := range second.assignment {
+		if inherited[bucket] {
+			dissolved[bucket] = true
+			dissolved[first.assignment[item]] = true
+		}
+	}
+
+	child := make([]int, len(first.assignment))
+	for item, bucket := range first.assignment {
+		switch {
+		case inherited[second.assignment[item]]:
+			child[item] = second.assignment[item]
+		case dissolved[bucket]:
+			child[item] = unassigned
+		default:
+			child[item] = bucket
+		}
+	}
+
+	return child
+}
+
+// mutate dissolves a random bucket among the ones with any assigned item, i.e. it leaves all its items unassigned.
+func mutate(assignment []int, random *rand.Rand) {
+	var usedBuckets []int
+	isUsed := make(map[int]bool)
+
+	for _, bucket := range assignment {
+		if bucket != unassigned && !isUsed[bucket] {
+			isUsed[bucket] = true
+			usedBuckets = append(usedBuckets, bucket)
+		}
+	}
+
+	if len(usedBuckets) == 0 {
+		return
+	}
+
+	bucket := usedBuckets[random.Intn(len(usedBuckets))]
+
+	for item := range assignment {
+		if assignment[item] == bucket {
+			assignment[item] = unassigned
+		}
+	}
+}
+
+// repair assigns unassigned items to buckets without exceeding their MRB. Items are assigned starting from
+// the biggest one. Each of them is assigned to the used bucket with the least space left after the assignment,
+// or to a random unused bucket if it doesn't fit into any used one. If shuffle is true, items are assigned
+// in a random order instead. It returns optimizer.ErrCannotAssignToBucket if any item doesn't fit into any bucket.
+func repair(data *data.Data, assignment []int, shuffle bool, random *rand.Rand) error {
+	leftSpace := make([]int, len(data.MRB))
+	copy(leftSpace, data.MRB)
+
+	used := make([]bool, len(data.MRB))
+
+	var items []int
+	for item, bucket := range assignment {
+		if bucket == unassigned {
+			items = append(items, item)
+			continue
+		}
+
+		leftSpace[bucket] -= data.R[item][bucket]
+		used[bucket] = true
+	}
+
+	random.Shuffle(len(items), func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+
+	if !shuffle {
+		sort.SliceStable(items, func(i, j int) bool {
+			return minSize(data.R[items[i]]) > minSize(data.R[items[j]])
+		})
+	}
+
+	unusedBuckets := random.Perm(len(data.MRB))
+
+	for _, item := range items {
+		bucket := bestUsedBucket(data.R[item], leftSpace, used)
+
+		if bucket == unassigned {
+			for _, candidate := range unusedBuckets {
+				if !used[candidate] && data.R[item][candidate] <= leftSpace[candidate] {
+					bucket = candidate
+					break
+				}
+			}
+		}
+
+		if bucket == unassigned {
+			return optimizer.ErrCannotAssignToBucket
+		}
+
+		assignment[item] = bucket
+		leftSpace[bucket] -= data.R[item][bucket]
+		used[bucket] = true
+	}
+
+	return nil
+}
+
+func bestUsedBucket(sizes, leftSpace []int, used []bool) int {
+	best := unassigned
+
+	for bucket, size := range sizes {
+		if !used[bucket] || size > leftSpace[bucket] {
+			continue
+		}
+
+		if best == unassigned || leftSpace[bucket]-size < leftSpace[best]-sizes[best] {
+			best = bucket
+		}
+	}
+
+	return best
+}
+
+func minSize(sizes []int) int {
+	if len(sizes) == 0 {
+		return 0
+	}
+
+	result := sizes[0]
+	for _, size := range sizes[1:] {
+		if size < result {
+			result = size
+		}
+	}
+	return result
+}
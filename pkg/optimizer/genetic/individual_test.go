@@ -0,0 +1,202 @@
+package genetic
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newIndividual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should compute number of used buckets and spread of occupied space", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{MRB: []int{10, 10, 10}, R: [][]int{{2, 2, 2}, {2, 2, 2}, {2, 2, 2}, {2, 2, 2}}}
+
+		ind := newIndividual(d, []int{0, 2, 0, 0})
+
+		assert.Equal(t, 2, ind.fitness.count)
+		assert.InDelta(t, 0.4, ind.fitness.spread, 1e-9)
+	})
+
+	t.Run("should measure load of buckets with occupied space", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{MRB: []int{10, 10}, R: [][]int{{9, 9}, {1, 1}, {1, 1}}}
+
+		bigItemAlone := newIndividual(d, []int{0, 1, 1})
+		bigItemShared := newIndividual(d, []int{0, 0, 1})
+
+		assert.True(t, bigItemShared.fitness.isBetter(bigItemAlone.fitness))
+	})
+}
+
+func Test_fitness_isBetter(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, fitness{count: 1, spread: 0.4}.isBetter(fitness{count: 2, spread: 1}))
+	assert.True(t, fitness{count: 2, spread: 1}.isBetter(fitness{count: 2, spread: 0.8}))
+	assert.False(t, fitness{count: 2, spread: 0.8}.isBetter(fitness{count: 2, spread: 0.8}))
+}
+
+func Test_crossover(t *testing.T) {
+	t.Parallel()
+
+	first := &individual{assignment: []int{0, 0, 1, 1, 2}}
+	second := &individual{assignment: []int{3, 3, 3, 4, 4}}
+
+	expectedChildren := [][]int{
+		{0, 0, 1, 1, 2},
+		{3, 3, 3, unassigned, 2},
+		{0, 0, unassigned, 4, 4},
+		{3, 3, 3, 4, 4},
+	}
+
+	var children [][]int
+	for seed := int64(0); seed < 20; seed++ {
+		child := crossover(first, second, rand.New(rand.NewSource(seed)))
+
+		assert.Contains(t, expectedChildren, child)
+		if !containsChild(children, child) {
+			children = append(children, child)
+		}
+	}
+
+	assert.ElementsMatch(t, expectedChildren, children)
+}
+
+func Test_mutate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should dissolve random bucket", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{0, 1, 0, 2, 1}
+		expected := make([]int, len(assignment))
+		copy(expected, assignment)
+
+		mutate(assignment, rand.New(rand.NewSource(1)))
+
+		var dissolved int
+		for item, bucket := range assignment {
+			if bucket == unassigned {
+				dissolved = expected[item]
+			}
+		}
+
+		for item, bucket := range expected {
+			if bucket == dissolved {
+				expected[item] = unassigned
+			}
+		}
+
+		assert.Equal(t, expected, assignment)
+	})
+
+	t.Run("should always empty exactly one used bucket", func(t *testing.T) {
+		t.Parallel()
+
+		initial := []int{unassigned, 0, unassigned, unassigned, 2, unassigned}
+
+		for seed := int64(0); seed < 20; seed++ {
+			assignment := make([]int, len(initial))
+			copy(assignment, initial)
+
+			mutate(assignment, rand.New(rand.NewSource(seed)))
+
+			var changed int
+			for item, bucket := range assignment {
+				if bucket != initial[item] {
+					assert.Equal(t, unassigned, bucket)
+					changed++
+				}
+			}
+
+			assert.Equal(t, 1, changed)
+		}
+	})
+
+	t.Run("should do nothing if no bucket is used", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{unassigned, unassigned}
+
+		mutate(assignment, rand.New(rand.NewSource(1)))
+
+		assert.Equal(t, []int{unassigned, unassigned}, assignment)
+	})
+}
+
+func Test_repair(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 8, 5},
+		R: [][]int{
+			{4, 3, 2},
+			{6, 5, 4},
+			{2, 3, 1},
+			{3, 3, 3},
+		},
+	}
+
+	t.Run("should assign items to the best fitting used buckets", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{0, 1, unassigned, unassigned}
+
+		err := repair(d, assignment, false, rand.New(rand.NewSource(1)))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 0, 1}, assignment)
+	})
+
+	t.Run("should use unused bucket if item doesn't fit into used ones", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{unassigned, 0, 0, unassigned}
+
+		err := repair(d, assignment, false, rand.New(rand.NewSource(1)))
+
+		assert.NoError(t, err)
+		assert.NoError(t, optimizer.Validate(d, helper.ToResult(assignment, len(d.MRB))))
+		assert.NotEqual(t, 0, assignment[0])
+	})
+
+	t.Run("should assign all items in random order", func(t *testing.T) {
+		t.Parallel()
+
+		for seed := int64(0); seed < 10; seed++ {
+			assignment := []int{unassigned, unassigned, unassigned, unassigned}
+
+			err := repair(d, assignment, true, rand.New(rand.NewSource(seed)))
+
+			assert.NoError(t, err)
+			assert.NoError(t, optimizer.Validate(d, helper.ToResult(assignment, len(d.MRB))))
+		}
+	})
+
+	t.Run("should return error if item doesn't fit into any bucket", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{unassigned, unassigned}
+
+		err := repair(&data.Data{MRB: []int{5}, R: [][]int{{4}, {4}}}, assignment, false, rand.New(rand.NewSource(1)))
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+	})
+}
+
+func containsChild(children [][]int, child []int) bool {
+	for _, c := range children {
+		if assert.ObjectsAreEqual(c, child) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package helper
 import (
 	"math/rand"
 	"sort"
+	"sync"
 )
 
 // ReorderBucketsFunc is a function that defines the order in which buckets will be used during optimization.
@@ -37,6 +38,21 @@ func RandomReorder(bucketSizes []int) []int {
 	return rand.Perm(len(bucketSizes))
 }
 
+// NewRandomReorder returns ReorderBucketsFunc that permutes buckets in a random fashion
+// using the given source of randomness. Consecutive calls of the returned function produce the same orders
+// for sources with the same seed. The returned function is safe for concurrent use.
+func NewRandomReorder(source rand.Source) ReorderBucketsFunc {
+	random := rand.New(source)
+	var mutex sync.Mutex
+
+	return func(bucketSizes []int) []int {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return random.Perm(len(bucketSizes))
+	}
+}
+
 type bucketIndexSize struct {
 	index int
 	size  int
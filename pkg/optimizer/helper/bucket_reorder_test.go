@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,31 @@ func TestRandomReorder(t *testing.T) {
 	assert.Equal(t, []int{1, 1, 1, 1}, elementCount)
 }
 
+func TestNewRandomReorder(t *testing.T) {
+	t.Parallel()
+
+	bucketSizes := []int{10, 5, 9, 2, 7, 1, 3, 8}
+
+	t.Run("should return permutation of buckets", func(t *testing.T) {
+		t.Parallel()
+
+		reorder := NewRandomReorder(rand.NewSource(1))(bucketSizes)
+
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, reorder)
+	})
+
+	t.Run("should return the same orders for the same seed", func(t *testing.T) {
+		t.Parallel()
+
+		first := NewRandomReorder(rand.NewSource(42))
+		second := NewRandomReorder(rand.NewSource(42))
+
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, first(bucketSizes), second(bucketSizes))
+		}
+	})
+}
+
 func TestAscendingTotalSizeOfItemsInBucketReorder(t *testing.T) {
 	t.Parallel()
 
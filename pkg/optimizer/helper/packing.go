@@ -0,0 +1,138 @@
+package helper
+
+import "github.com/lothar1998/v2x-optimizer/pkg/data"
+
+// Packing represents the assignment of items to buckets along with the load of buckets. It allows for evaluating
+// and applying changes of the assignment in constant time, so it is convenient for local search optimizers.
+// Packing doesn't verify capacity of buckets on its own, so the caller has to check moves using Fits or CanMove.
+// Among packings with the same number of used buckets, local search optimizers prefer the ones with lopsided load
+// of buckets, since they are closer to emptying a bucket.
+type Packing struct {
+	data *data.Data
+
+	assignment []int
+	load       []int
+	itemCount  []int
+
+	usedBuckets   []int
+	usedPositions []int
+}
+
+// NewPacking returns Packing of the given assignment. The assignment is copied, so it may be modified afterwards.
+func NewPacking(data *data.Data, assignment []int) *Packing {
+	n := len(data.MRB)
+
+	p := &Packing{
+		data:          data,
+		assignment:    make([]int, len(assignment)),
+		load:          make([]int, n),
+		itemCount:     make([]int, n),
+		usedPositions: make([]int, n),
+	}
+
+	copy(p.assignment, assignment)
+
+	for item, bucket := range p.assignment {
+		p.load[bucket] += data.R[item][bucket]
+		p.itemCount[bucket]++
+	}
+
+	for bucket := range p.usedPositions {
+		p.usedPositions[bucket] = -1
+		if p.itemCount[bucket] > 0 {
+			p.enable(bucket)
+		}
+	}
+
+	return p
+}
+
+// Data returns the data of the packing.
+func (p *Packing) Data() *data.Data {
+	return p.data
+}
+
+// Assignment returns the current assignment of items to buckets. It must not be modified by the caller.
+func (p *Packing) Assignment() []int {
+	return p.assignment
+}
+
+// Bucket returns the bucket to which the item is assigned.
+func (p *Packing) Bucket(item int) int {
+	return p.assignment[item]
+}
+
+// Load returns the space of the bucket occupied by items.
+func (p *Packing) Load(bucket int) int {
+	return p.load[bucket]
+}
+
+// ItemCount returns the number of items assigned to the bucket.
+func (p *Packing) ItemCount(bucket int) int {
+	return p.itemCount[bucket]
+}
+
+// UsedBuckets returns buckets with at least one item in an arbitrary order. It must not be modified by the caller.
+func (p *Packing) UsedBuckets() []int {
+	return p.usedBuckets
+}
+
+// UsedCount returns the number of buckets with at least one item.
+func (p *Packing) UsedCount() int {
+	return len(p.usedBuckets)
+}
+
+// Fits returns true if the item fits into the left space of the bucket.
+func (p *Packing) Fits(item, bucket int) bool {
+	return p.load[bucket]+p.data.R[item][bucket] <= p.data.MRB[bucket]
+}
+
+// CanMove returns true if the item can be moved from its current bucket to the given one.
+func (p *Packing) CanMove(item, bucket int) bool {
+	return p.assignment[item] != bucket && p.Fits(item, bucket)
+}
+
+// Move moves the item from its current bucket to the given one.
+func (p *Packing) Move(item, bucket int) {
+	source := p.assignment[item]
+
+	p.load[source] -= p.data.R[item][source]
+	p.load[bucket] += p.data.R[item][bucket]
+	p.itemCount[source]--
+	p.itemCount[bucket]++
+	p.assignment[item] = bucket
+
+	if p.itemCount[source] == 0 {
+		p.disable(source)
+	}
+
+	if p.itemCount[bucket] == 1 {
+		p.enable(bucket)
+	}
+}
+
+// SquaredFill returns the squared ratio of the given load to the size of the bucket.
+// The sum of squared fills is bigger for lopsided load of buckets.
+func (p *Packing) SquaredFill(bucket, load int) float64 {
+	if p.data.MRB[bucket] == 0 {
+		return 0
+	}
+
+	fill := float64(load) / float64(p.data.MRB[bucket])
+	return fill * fill
+}
+
+func (p *Packing) enable(bucket int) {
+	p.usedPositions[bucket] = len(p.usedBuckets)
+	p.usedBuckets = append(p.usedBuckets, bucket)
+}
+
+func (p *Packing) disable(bucket int) {
+	position := p.usedPositions[bucket]
+	last := p.usedBuckets[len(p.usedBuckets)-1]
+
+	p.usedBuckets[position] = last
+	p.usedPositions[last] = position
+	p.usedBuckets = p.usedBuckets[:len(p.usedBuckets)-1]
+	p.usedPositions[bucket] = -1
+}
@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacking(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 8, 5},
+		R: [][]int{
+			{4, 3, 2},
+			{6, 5, 4},
+			{2, 3, 1},
+		},
+	}
+
+	t.Run("should compute load of buckets", func(t *testing.T) {
+		t.Parallel()
+
+		assignment := []int{0, 0, 2}
+		p := NewPacking(d, assignment)
+		assignment[0] = 1
+
+		assert.Equal(t, []int{0, 0, 2}, p.Assignment())
+		assert.Equal(t, 10, p.Load(0))
+		assert.Equal(t, 0, p.Load(1))
+		assert.Equal(t, 1, p.Load(2))
+		assert.Equal(t, 2, p.ItemCount(0))
+		assert.Equal(t, 2, p.UsedCount())
+		assert.ElementsMatch(t, []int{0, 2}, p.UsedBuckets())
+	})
+
+	t.Run("should check if item fits into bucket", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPacking(d, []int{0, 0, 2})
+
+		assert.True(t, p.Fits(2, 1))
+		assert.False(t, p.Fits(2, 0))
+		assert.False(t, p.CanMove(0, 0))
+		assert.True(t, p.CanMove(0, 2))
+	})
+
+	t.Run("should move item and update used buckets", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPacking(d, []int{0, 1, 2})
+
+		p.Move(1, 0)
+
+		assert.Equal(t, []int{0, 0, 2}, p.Assignment())
+		assert.Equal(t, 10, p.Load(0))
+		assert.Equal(t, 0, p.Load(1))
+		assert.ElementsMatch(t, []int{0, 2}, p.UsedBuckets())
+
+		p.Move(2, 1)
+
+		assert.Equal(t, 1, p.Bucket(2))
+		assert.Equal(t, 3, p.Load(1))
+		assert.ElementsMatch(t, []int{0, 1}, p.UsedBuckets())
+	})
+
+	t.Run("should compute squared fill of bucket", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewPacking(d, []int{0, 1, 2})
+
+		assert.Equal(t, 0.25, p.SquaredFill(0, 5))
+		assert.Zero(t, NewPacking(&data.Data{MRB: []int{0}}, nil).SquaredFill(0, 0))
+	})
+}
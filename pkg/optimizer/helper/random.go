@@ -0,0 +1,30 @@
+package helper
+
+import (
+	"math/rand"
+	"sync"
+)
+
+type lockedSource struct {
+	mutex  sync.Mutex
+	source rand.Source
+}
+
+// NewLockedSource returns rand.Source that is safe for concurrent use. It is intended for randomized optimizers,
+// which may be used by many goroutines at the same time, e.g. in performance tests. The source of such an optimizer
+// has to be safe for concurrent use if Optimize is called concurrently.
+func NewLockedSource(source rand.Source) rand.Source {
+	return &lockedSource{source: source}
+}
+
+func (l *lockedSource) Int63() int64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.source.Int63()
+}
+
+func (l *lockedSource) Seed(seed int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.source.Seed(seed)
+}
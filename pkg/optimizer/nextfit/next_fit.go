@@ -18,7 +18,8 @@ func (nf NextFit) Optimize(ctx context.Context, data *data.Data) (*optimizer.Res
 	n := len(data.MRB)
 
 	sequence := make([]int, len(data.R))
-	leftSpace := data.MRB
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
 
 	var currIndex int
 
@@ -29,7 +29,8 @@ func (nkf NextKFit) Optimize(ctx context.Context, data *data.Data) (*optimizer.R
 	}
 
 	sequence := make([]int, len(data.R))
-	leftSpace := data.MRB
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
 
 	var firstBucketOpen int
 	var lastBucketOpen = firstBucketOpen + nkf.K - 1
@@ -0,0 +1,40 @@
+package online
+
+import (
+	"math"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+)
+
+// BestFit is an online optimizer that assigns vehicles to the RRH with the lowest value of FitnessFunc,
+// the same way as bestfit.BestFit does.
+type BestFit struct {
+	*state
+}
+
+// NewBestFit returns BestFit for RRHs with the given numbers of resource blocks.
+func NewBestFit(mrb []int, fitnessFunc bestfit.FitnessFunc) *BestFit {
+	return &BestFit{newState(mrb, bestFit(fitnessFunc))}
+}
+
+func bestFit(fitnessFunc bestfit.FitnessFunc) chooseFunc {
+	return func(costs, leftSpace, mrb []int) int {
+		bestRRH := -1
+		minFitness := math.Inf(1)
+
+		for rrh := range leftSpace {
+			fitnessValue := fitnessFunc(leftSpace[rrh], costs[rrh], mrb[rrh])
+
+			if fitnessValue == 0 {
+				return rrh
+			}
+
+			if fitnessValue < minFitness && fitnessValue > 0 {
+				bestRRH = rrh
+				minFitness = fitnessValue
+			}
+		}
+
+		return bestRRH
+	}
+}
@@ -0,0 +1,62 @@
+package online
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBestFit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should assign arriving vehicles the same way as offline best-fit", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{
+			MRB: []int{14, 15, 8, 10},
+			R: [][]int{
+				{6, 3, 2, 1},
+				{9, 16, 5, 3},
+				{9, 10, 7, 8},
+				{6, 3, 2, 1},
+				{1, 8, 1, 5},
+				{1, 7, 2, 2},
+			},
+		}
+
+		for _, fitnessFunc := range []bestfit.FitnessFunc{bestfit.FitnessClassic, bestfit.FitnessWithBucketSize} {
+			expected, err := bestfit.BestFit{FitnessFunc: fitnessFunc}.Optimize(context.TODO(), d)
+			assert.NoError(t, err)
+
+			var opt Optimizer = NewBestFit(d.MRB, fitnessFunc)
+			for vehicleID, costs := range d.R {
+				assert.NoError(t, opt.Add(vehicleID, costs))
+			}
+
+			assignment := opt.Assignment()
+			for vehicleID, rrh := range expected.VehiclesToRRHAssignment {
+				assert.Equal(t, rrh, assignment[vehicleID])
+			}
+			assert.Equal(t, expected.RRHCount, opt.RRHCount())
+		}
+	})
+
+	t.Run("should migrate vehicle to the best fitted RRH", func(t *testing.T) {
+		t.Parallel()
+
+		opt := NewBestFit([]int{10, 10, 10}, bestfit.FitnessClassic)
+
+		assert.NoError(t, opt.Add(1, []int{6, 6, 6}))
+		assert.NoError(t, opt.Add(2, []int{3, 3, 3}))
+		assert.NoError(t, opt.Add(3, []int{5, 8, 5}))
+		assert.NoError(t, opt.UpdateCosts(2, []int{5, 2, 4}))
+		assert.NoError(t, opt.UpdateCosts(1, []int{6, 6, 3}))
+		assert.NoError(t, opt.UpdateCosts(1, []int{11, 6, 3}))
+
+		assert.Equal(t, map[int]int{1: 2, 2: 1, 3: 1}, opt.Assignment())
+		assert.Equal(t, 2, opt.Migrations())
+	})
+}
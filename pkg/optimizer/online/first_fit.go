@@ -0,0 +1,21 @@
+package online
+
+// FirstFit is an online optimizer that assigns vehicles to the first RRH with enough left space,
+// the same way as firstfit.FirstFit does.
+type FirstFit struct {
+	*state
+}
+
+// NewFirstFit returns FirstFit for RRHs with the given numbers of resource blocks.
+func NewFirstFit(mrb []int) *FirstFit {
+	return &FirstFit{newState(mrb, firstFit)}
+}
+
+func firstFit(costs, leftSpace, _ []int) int {
+	for rrh := range leftSpace {
+		if costs[rrh] <= leftSpace[rrh] {
+			return rrh
+		}
+	}
+	return -1
+}
@@ -0,0 +1,56 @@
+package online
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstFit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should assign arriving vehicles the same way as offline first-fit", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{
+			MRB: []int{12, 15, 8, 10},
+			R: [][]int{
+				{6, 3, 2, 1},
+				{7, 8, 5, 3},
+				{9, 10, 7, 8},
+				{6, 3, 2, 1},
+				{7, 8, 1, 5},
+			},
+		}
+
+		expected, err := firstfit.FirstFit{}.Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		var opt Optimizer = NewFirstFit(d.MRB)
+		for vehicleID, costs := range d.R {
+			assert.NoError(t, opt.Add(vehicleID, costs))
+		}
+
+		assignment := opt.Assignment()
+		for vehicleID, rrh := range expected.VehiclesToRRHAssignment {
+			assert.Equal(t, rrh, assignment[vehicleID])
+		}
+		assert.Equal(t, expected.RRHCount, opt.RRHCount())
+	})
+
+	t.Run("should migrate vehicle to the first RRH with enough left space", func(t *testing.T) {
+		t.Parallel()
+
+		opt := NewFirstFit([]int{4, 4, 4})
+
+		assert.NoError(t, opt.Add(1, []int{3, 3, 3}))
+		assert.NoError(t, opt.Add(2, []int{1, 3, 1}))
+		assert.NoError(t, opt.UpdateCosts(2, []int{2, 5, 2}))
+
+		assert.Equal(t, map[int]int{1: 0, 2: 2}, opt.Assignment())
+		assert.Equal(t, 1, opt.Migrations())
+	})
+}
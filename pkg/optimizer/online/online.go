@@ -0,0 +1,176 @@
+// Package online provides optimizers that maintain an assignment of vehicles to RRHs incrementally
+// while vehicles arrive, leave and move, instead of optimizing a single snapshot of data.Data.
+package online
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+)
+
+var (
+	// ErrVehicleExists is returned by Add if the vehicle has already been added.
+	ErrVehicleExists = errors.New("vehicle already exists")
+	// ErrUnknownVehicle is returned if the vehicle has not been added or has already been removed.
+	ErrUnknownVehicle = errors.New("unknown vehicle")
+	// ErrInvalidCosts is returned if the number of costs is not equal to the number of RRHs.
+	ErrInvalidCosts = errors.New("invalid costs")
+)
+
+// Optimizer maintains an assignment of vehicles to RRHs. Costs of a vehicle are the numbers of resource blocks
+// it requires from consecutive RRHs, i.e. they correspond to a row of data.R. Once assigned, a vehicle stays
+// at its RRH as long as the RRH is able to serve it, so the only changes of the assignment are migrations
+// of vehicles whose costs have been updated and no longer fit into their RRHs.
+// If a vehicle cannot be assigned to any RRH, optimizer.ErrCannotAssignToBucket is returned
+// and the state of the optimizer is not changed.
+// Implementations are not safe for concurrent use.
+type Optimizer interface {
+	// Add assigns a new vehicle to some RRH.
+	Add(vehicleID int, costs []int) error
+	// Remove releases resources of the vehicle.
+	Remove(vehicleID int) error
+	// UpdateCosts changes costs of the vehicle and migrates it to another RRH if its RRH cannot serve it anymore.
+	UpdateCosts(vehicleID int, costs []int) error
+	// Assignment returns a copy of the current assignment of vehicles to RRHs.
+	Assignment() map[int]int
+	// RRHCount returns the number of RRHs that serve at least one vehicle.
+	RRHCount() int
+	// Migrations returns the number of migrations of vehicles between RRHs made so far.
+	Migrations() int
+}
+
+// chooseFunc returns the RRH to which the vehicle with the given costs should be assigned
+// or -1 if there is no RRH with enough left space.
+type chooseFunc func(costs, leftSpace, mrb []int) int
+
+// state is the assignment shared by all online optimizers, which differ only in the way they choose RRHs.
+type state struct {
+	choose       chooseFunc
+	mrb          []int
+	leftSpace    []int
+	vehicleCount []int
+	costs        map[int][]int
+	assignment   map[int]int
+	migrations   int
+}
+
+func newState(mrb []int, choose chooseFunc) *state {
+	s := &state{
+		choose:       choose,
+		mrb:          make([]int, len(mrb)),
+		leftSpace:    make([]int, len(mrb)),
+		vehicleCount: make([]int, len(mrb)),
+		costs:        make(map[int][]int),
+		assignment:   make(map[int]int),
+	}
+
+	copy(s.mrb, mrb)
+	copy(s.leftSpace, mrb)
+
+	return s
+}
+
+func (s *state) Add(vehicleID int, costs []int) error {
+	if _, ok := s.assignment[vehicleID]; ok {
+		return fmt.Errorf("%w: %d", ErrVehicleExists, vehicleID)
+	}
+
+	if len(costs) != len(s.mrb) {
+		return fmt.Errorf("%w: expected %d costs but got %d", ErrInvalidCosts, len(s.mrb), len(costs))
+	}
+
+	rrh := s.choose(costs, s.leftSpace, s.mrb)
+	if rrh < 0 {
+		return optimizer.ErrCannotAssignToBucket
+	}
+
+	s.assign(vehicleID, copyCosts(costs), rrh)
+
+	return nil
+}
+
+func (s *state) Remove(vehicleID int) error {
+	if _, ok := s.assignment[vehicleID]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownVehicle, vehicleID)
+	}
+
+	s.unassign(vehicleID)
+
+	return nil
+}
+
+func (s *state) UpdateCosts(vehicleID int, costs []int) error {
+	rrh, ok := s.assignment[vehicleID]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownVehicle, vehicleID)
+	}
+
+	if len(costs) != len(s.mrb) {
+		return fmt.Errorf("%w: expected %d costs but got %d", ErrInvalidCosts, len(s.mrb), len(costs))
+	}
+
+	oldCosts := s.unassign(vehicleID)
+
+	if costs[rrh] <= s.leftSpace[rrh] {
+		s.assign(vehicleID, copyCosts(costs), rrh)
+		return nil
+	}
+
+	newRRH := s.choose(costs, s.leftSpace, s.mrb)
+	if newRRH < 0 {
+		s.assign(vehicleID, oldCosts, rrh)
+		return optimizer.ErrCannotAssignToBucket
+	}
+
+	s.assign(vehicleID, copyCosts(costs), newRRH)
+	s.migrations++
+
+	return nil
+}
+
+func (s *state) Assignment() map[int]int {
+	assignment := make(map[int]int, len(s.assignment))
+	for vehicleID, rrh := range s.assignment {
+		assignment[vehicleID] = rrh
+	}
+	return assignment
+}
+
+func (s *state) RRHCount() int {
+	var count int
+	for _, vehicles := range s.vehicleCount {
+		if vehicles > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *state) Migrations() int {
+	return s.migrations
+}
+
+func (s *state) assign(vehicleID int, costs []int, rrh int) {
+	s.costs[vehicleID] = costs
+	s.assignment[vehicleID] = rrh
+	s.leftSpace[rrh] -= costs[rrh]
+	s.vehicleCount[rrh]++
+}
+
+func (s *state) unassign(vehicleID int) []int {
+	costs, rrh := s.costs[vehicleID], s.assignment[vehicleID]
+
+	delete(s.costs, vehicleID)
+	delete(s.assignment, vehicleID)
+	s.leftSpace[rrh] += costs[rrh]
+	s.vehicleCount[rrh]--
+
+	return costs
+}
+
+func copyCosts(costs []int) []int {
+	c := make([]int, len(costs))
+	copy(c, costs)
+	return c
+}
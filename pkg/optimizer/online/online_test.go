@@ -0,0 +1,110 @@
+package online
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should assign added vehicles and release resources of removed ones", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.NoError(t, s.Add(1, []int{3, 3}))
+		assert.NoError(t, s.Add(2, []int{3, 3}))
+		assert.Equal(t, map[int]int{1: 0, 2: 1}, s.Assignment())
+		assert.Equal(t, 2, s.RRHCount())
+
+		assert.NoError(t, s.Remove(1))
+		assert.NoError(t, s.Add(3, []int{2, 2}))
+		assert.Equal(t, map[int]int{2: 1, 3: 0}, s.Assignment())
+		assert.Equal(t, []int{3, 2}, s.leftSpace)
+		assert.Zero(t, s.Migrations())
+	})
+
+	t.Run("should keep vehicle at its RRH if updated costs fit into it", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.NoError(t, s.Add(1, []int{2, 1}))
+		assert.NoError(t, s.Add(2, []int{2, 1}))
+		assert.NoError(t, s.UpdateCosts(1, []int{3, 1}))
+
+		assert.Equal(t, map[int]int{1: 0, 2: 0}, s.Assignment())
+		assert.Equal(t, []int{0, 5}, s.leftSpace)
+		assert.Zero(t, s.Migrations())
+	})
+
+	t.Run("should migrate vehicle if updated costs don't fit into its RRH", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.NoError(t, s.Add(1, []int{2, 1}))
+		assert.NoError(t, s.Add(2, []int{2, 1}))
+		assert.NoError(t, s.UpdateCosts(1, []int{4, 2}))
+
+		assert.Equal(t, map[int]int{1: 1, 2: 0}, s.Assignment())
+		assert.Equal(t, []int{3, 3}, s.leftSpace)
+		assert.Equal(t, 1, s.Migrations())
+	})
+
+	t.Run("should not change state if vehicle cannot be assigned", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.NoError(t, s.Add(1, []int{2, 4}))
+		assert.NoError(t, s.Add(2, []int{4, 2}))
+
+		assert.ErrorIs(t, s.Add(3, []int{4, 4}), optimizer.ErrCannotAssignToBucket)
+		assert.ErrorIs(t, s.UpdateCosts(1, []int{6, 4}), optimizer.ErrCannotAssignToBucket)
+
+		assert.Equal(t, map[int]int{1: 0, 2: 1}, s.Assignment())
+		assert.Equal(t, []int{3, 3}, s.leftSpace)
+		assert.Equal(t, []int{2, 4}, s.costs[1])
+		assert.Zero(t, s.Migrations())
+	})
+
+	t.Run("should return errors for unknown and duplicated vehicles", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.NoError(t, s.Add(1, []int{2, 4}))
+
+		assert.ErrorIs(t, s.Add(1, []int{2, 4}), ErrVehicleExists)
+		assert.ErrorIs(t, s.Remove(2), ErrUnknownVehicle)
+		assert.ErrorIs(t, s.UpdateCosts(2, []int{2, 4}), ErrUnknownVehicle)
+	})
+
+	t.Run("should return error if number of costs differs from number of RRHs", func(t *testing.T) {
+		t.Parallel()
+
+		s := newState([]int{5, 5}, firstFit)
+
+		assert.ErrorIs(t, s.Add(1, []int{2}), ErrInvalidCosts)
+		assert.NoError(t, s.Add(1, []int{2, 4}))
+		assert.ErrorIs(t, s.UpdateCosts(1, []int{2, 4, 1}), ErrInvalidCosts)
+	})
+
+	t.Run("should not depend on slices passed by caller", func(t *testing.T) {
+		t.Parallel()
+
+		mrb, costs := []int{5, 5}, []int{2, 4}
+		s := newState(mrb, firstFit)
+
+		assert.NoError(t, s.Add(1, costs))
+		mrb[0], costs[0] = 0, 5
+
+		assert.NoError(t, s.Remove(1))
+		assert.Equal(t, []int{5, 5}, s.leftSpace)
+		assert.Equal(t, []int{5, 5}, s.mrb)
+	})
+}
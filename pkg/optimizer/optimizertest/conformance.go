@@ -0,0 +1,100 @@
+// Package optimizertest provides utilities for testing implementations of optimizer.Optimizer.
+package optimizertest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/stretchr/testify/assert"
+)
+
+// Instances returns the data used by TestConformance. Each call returns new copies,
+// so the caller is free to modify them.
+func Instances() []*data.Data {
+	return []*data.Data{
+		{
+			MRB: []int{10, 10, 10},
+			R: [][]int{
+				{6, 6, 6},
+				{2, 2, 2},
+				{3, 3, 3},
+				{5, 5, 5},
+				{4, 4, 4},
+			},
+		},
+		{
+			MRB: []int{12, 7, 15, 9, 11},
+			R: [][]int{
+				{3, 5, 2, 4, 6},
+				{7, 2, 6, 3, 1},
+				{2, 2, 9, 5, 4},
+				{4, 6, 3, 2, 8},
+				{5, 1, 4, 7, 2},
+				{1, 3, 5, 6, 3},
+				{6, 4, 1, 2, 5},
+			},
+		},
+		{
+			MRB: []int{5, 5},
+			R: [][]int{
+				{4, 4},
+				{4, 4},
+				{4, 4},
+			},
+			Metadata: &data.Metadata{
+				Geometry: &data.Geometry{
+					Vehicles: []data.Point{{X: 0.1, Y: 0.1}, {X: 0.5, Y: 0.5}, {X: 0.9, Y: 0.9}},
+					Stations: []data.Point{{X: 0.25, Y: 0.25}, {X: 0.75, Y: 0.75}},
+				},
+			},
+		},
+	}
+}
+
+// TestConformance verifies that the optimizer satisfies the contract of optimizer.Optimizer.
+// It runs the optimizer twice on each of the Instances and checks that the input data is not modified
+// and that each result is either a feasible solution or optimizer.ErrCannotAssignToBucket.
+// Running the optimizer twice on the same data detects optimizers that rely on the data modified by a previous run.
+func TestConformance(t *testing.T, opt optimizer.Optimizer) {
+	t.Helper()
+
+	for i, d := range Instances() {
+		expectedData := Copy(d)
+
+		for run := 0; run < 2; run++ {
+			result, err := opt.Optimize(context.TODO(), d)
+
+			assert.Equal(t, expectedData, d, "instance %d has been modified by run %d", i, run)
+
+			if err != nil {
+				assert.True(t, errors.Is(err, optimizer.ErrCannotAssignToBucket),
+					"unexpected error for instance %d in run %d: %v", i, run, err)
+				continue
+			}
+
+			assert.NoError(t, optimizer.Validate(expectedData, result),
+				"infeasible result for instance %d in run %d", i, run)
+		}
+	}
+}
+
+// Copy returns a deep copy of MRB and R of the data. Metadata is shared, since optimizers don't use it.
+func Copy(d *data.Data) *data.Data {
+	c := &data.Data{
+		MRB:      make([]int, len(d.MRB)),
+		R:        make([][]int, len(d.R)),
+		Metadata: d.Metadata,
+	}
+
+	copy(c.MRB, d.MRB)
+
+	for i := range d.R {
+		c.R[i] = make([]int, len(d.R[i]))
+		copy(c.R[i], d.R[i])
+	}
+
+	return c
+}
@@ -0,0 +1,211 @@
+package ruinandrecreate
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// RuinAndRecreate is a large neighborhood search optimizer that improves the solution found by InitialOptimizer.
+// In each iteration it closes RuinedBuckets least loaded buckets (in terms of the ratio of occupied space
+// to the size of a bucket), unassigns their items and reassigns them in a random order. Each item is assigned to
+// the "best" bucket among the open ones according to bestfit.FitnessFunc. If the item doesn't fit into any of them,
+// the first bucket that fits is opened, where the order of buckets is defined by helper.ReorderBucketsFunc
+// applied to the left space of buckets. The new solution replaces the current one if it doesn't use more buckets.
+// The process stops after Iterations iterations, after TimeLimit (if greater than zero) or when the context is done,
+// and returns the current solution. If Iterations is not greater than zero, the process stops only after TimeLimit
+// or when the context is done, so the budget of the optimizer should be defined by one of them.
+// If Source is nil, the source seeded with the current time is used (see helper.NewLockedSource).
+type RuinAndRecreate struct {
+	InitialOptimizer optimizer.Optimizer
+	RuinedBuckets    int
+	Iterations       int
+	TimeLimit        time.Duration
+	Source           rand.Source
+	helper.ReorderBucketsFunc
+	bestfit.FitnessFunc
+}
+
+func (r RuinAndRecreate) Optimize(ctx context.Context, data *data.Data) (*optimizer.Result, error) {
+	initialResult, err := r.InitialOptimizer.Optimize(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.TimeLimit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.TimeLimit)
+		defer cancel()
+	}
+
+	if len(data.R) == 0 {
+		return initialResult, nil
+	}
+
+	random := r.newRandom()
+
+	current := make([]int, len(data.R))
+	copy(current, initialResult.VehiclesToRRHAssignment)
+	currentCount := initialResult.RRHCount
+
+	for iteration := 0; r.Iterations <= 0 || iteration < r.Iterations; iteration++ {
+		select {
+		case <-ctx.Done():
+			return helper.ToResult(current, len(data.MRB)), nil
+		default:
+		}
+
+		candidate, ok := r.ruinAndRecreate(data, current, random)
+		if !ok {
+			continue
+		}
+
+		if count := usedCount(candidate, len(data.MRB)); count <= currentCount {
+			current = candidate
+			currentCount = count
+		}
+	}
+
+	return helper.ToResult(current, len(data.MRB)), nil
+}
+
+// ruinAndRecreate returns the new assignment or false if any of unassigned items doesn't fit into any bucket.
+func (r RuinAndRecreate) ruinAndRecreate(data *data.Data, assignment []int, random *rand.Rand) ([]int, bool) {
+	n := len(data.MRB)
+
+	candidate := make([]int, len(assignment))
+	copy(candidate, assignment)
+
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
+
+	isOpen := make([]bool, n)
+
+	for item, bucket := range candidate {
+		leftSpace[bucket] -= data.R[item][bucket]
+		isOpen[bucket] = true
+	}
+
+	isRuined := make([]bool, n)
+	for _, bucket := range r.leastLoadedBuckets(data, leftSpace, isOpen) {
+		isRuined[bucket] = true
+		isOpen[bucket] = false
+		leftSpace[bucket] = data.MRB[bucket]
+	}
+
+	var unassigned []int
+	for item, bucket := range candidate {
+		if isRuined[bucket] {
+			unassigned = append(unassigned, item)
+		}
+	}
+
+	random.Shuffle(len(unassigned), func(i, j int) {
+		unassigned[i], unassigned[j] = unassigned[j], unassigned[i]
+	})
+
+	for _, item := range unassigned {
+		bucket := r.bestOpenBucket(data, leftSpace, isOpen, item)
+
+		if bucket < 0 {
+			bucket = r.firstFittingClosedBucket(data, leftSpace, isOpen, item)
+		}
+
+		if bucket < 0 {
+			return nil, false
+		}
+
+		candidate[item] = bucket
+		leftSpace[bucket] -= data.R[item][bucket]
+		isOpen[bucket] = true
+	}
+
+	return candidate, true
+}
+
+func (r RuinAndRecreate) leastLoadedBuckets(data *data.Data, leftSpace []int, isOpen []bool) []int {
+	var buckets []int
+	for bucket, open := range isOpen {
+		if open {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	fill := func(bucket int) float64 {
+		if data.MRB[bucket] == 0 {
+			return 0
+		}
+		return float64(data.MRB[bucket]-leftSpace[bucket]) / float64(data.MRB[bucket])
+	}
+
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return fill(buckets[i]) < fill(buckets[j])
+	})
+
+	if r.RuinedBuckets < len(buckets) {
+		buckets = buckets[:r.RuinedBuckets]
+	}
+
+	return buckets
+}
+
+func (r RuinAndRecreate) bestOpenBucket(data *data.Data, leftSpace []int, isOpen []bool, item int) int {
+	bestBucket := -1
+	minFitness := math.Inf(1)
+
+	for bucket, open := range isOpen {
+		if !open {
+			continue
+		}
+
+		fitnessValue := r.FitnessFunc(leftSpace[bucket], data.R[item][bucket], data.MRB[bucket])
+
+		if fitnessValue == 0 {
+			return bucket
+		}
+
+		if fitnessValue < minFitness && fitnessValue > 0 {
+			bestBucket = bucket
+			minFitness = fitnessValue
+		}
+	}
+
+	return bestBucket
+}
+
+func (r RuinAndRecreate) firstFittingClosedBucket(data *data.Data, leftSpace []int, isOpen []bool, item int) int {
+	for _, bucket := range r.ReorderBucketsFunc(leftSpace) {
+		if !isOpen[bucket] && data.R[item][bucket] <= leftSpace[bucket] {
+			return bucket
+		}
+	}
+	return -1
+}
+
+func (r RuinAndRecreate) newRandom() *rand.Rand {
+	if r.Source == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return rand.New(rand.NewSource(r.Source.Int63()))
+}
+
+func usedCount(assignment []int, n int) int {
+	isUsed := make([]bool, n)
+
+	var count int
+	for _, bucket := range assignment {
+		if !isUsed[bucket] {
+			isUsed[bucket] = true
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,166 @@
+package ruinandrecreate
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/bestfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/optimizertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuinAndRecreate_Optimize(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 10, 10},
+		R: [][]int{
+			{6, 6, 6},
+			{2, 2, 2},
+			{3, 3, 3},
+			{5, 5, 5},
+			{4, 4, 4},
+		},
+	}
+
+	newRuinAndRecreate := func(seed int64) RuinAndRecreate {
+		return RuinAndRecreate{
+			InitialOptimizer:   bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic},
+			RuinedBuckets:      2,
+			Iterations:         100,
+			Source:             rand.NewSource(seed),
+			ReorderBucketsFunc: helper.NewRandomReorder(rand.NewSource(seed)),
+			FitnessFunc:        bestfit.FitnessClassic,
+		}
+	}
+
+	t.Run("should improve initial solution", func(t *testing.T) {
+		t.Parallel()
+
+		initialResult, err := bestfit.BestFit{FitnessFunc: bestfit.FitnessClassic}.Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, initialResult.RRHCount)
+
+		result, err := newRuinAndRecreate(1).Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.RRHCount)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should return the same result for the same seed", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := newRuinAndRecreate(5).Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		second, err := newRuinAndRecreate(5).Optimize(context.TODO(), d)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should never return solution worse than the initial one", func(t *testing.T) {
+		t.Parallel()
+
+		random := rand.New(rand.NewSource(1))
+
+		for i := 0; i < 20; i++ {
+			instance := randomData(random, 12, 5, 10, 25)
+
+			initialResult, err := firstfit.FirstFit{}.Optimize(context.TODO(), instance)
+			if err != nil {
+				continue
+			}
+
+			rr := RuinAndRecreate{
+				InitialOptimizer:   firstfit.FirstFit{},
+				RuinedBuckets:      1,
+				Iterations:         200,
+				Source:             rand.NewSource(int64(i)),
+				ReorderBucketsFunc: helper.NoOpReorder,
+				FitnessFunc:        bestfit.FitnessWithBucketSize,
+			}
+
+			result, err := rr.Optimize(context.TODO(), instance)
+
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, result.RRHCount, initialResult.RRHCount)
+			assert.NoError(t, optimizer.Validate(instance, result))
+		}
+	})
+
+	t.Run("should return error of initial optimizer", func(t *testing.T) {
+		t.Parallel()
+
+		infeasible := &data.Data{
+			MRB: []int{5, 5},
+			R:   [][]int{{4, 4}, {4, 4}, {4, 4}},
+		}
+
+		result, err := newRuinAndRecreate(1).Optimize(context.TODO(), infeasible)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should run until deadline of context if number of iterations is not limited", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+
+		rr := newRuinAndRecreate(1)
+		rr.Iterations = 0
+
+		result, err := rr.Optimize(ctx, d)
+
+		assert.NoError(t, err)
+		assert.Error(t, ctx.Err())
+		assert.Equal(t, 2, result.RRHCount)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should run until time limit if number of iterations is not limited", func(t *testing.T) {
+		t.Parallel()
+
+		rr := newRuinAndRecreate(1)
+		rr.Iterations = 0
+		rr.TimeLimit = 10 * time.Millisecond
+
+		result, err := rr.Optimize(context.TODO(), d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.RRHCount)
+		assert.NoError(t, optimizer.Validate(d, result))
+	})
+
+	t.Run("should conform to optimizer contract", func(t *testing.T) {
+		t.Parallel()
+
+		optimizertest.TestConformance(t, newRuinAndRecreate(1))
+	})
+}
+
+func randomData(random *rand.Rand, v, n, maxItemSize, maxBucketSize int) *data.Data {
+	d := &data.Data{MRB: make([]int, n), R: make([][]int, v)}
+
+	for j := range d.MRB {
+		d.MRB[j] = random.Intn(maxBucketSize) + 1
+	}
+
+	for i := range d.R {
+		d.R[i] = make([]int, n)
+		for j := range d.R[i] {
+			d.R[i][j] = random.Intn(maxItemSize) + 1
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,114 @@
+// Package scenario optimizes consecutive snapshots of data.Scenario and tracks changes between them.
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+)
+
+// Step is the result of optimization of a single snapshot along with its changes relative to the previous one.
+// The first snapshot is compared with the state without any vehicle and any enabled RRH.
+// Reassigned is the number of vehicles present in both snapshots that are assigned to different RRHs.
+type Step struct {
+	Result      *optimizer.Result
+	Vehicles    int
+	Entered     int
+	Left        int
+	SwitchedOn  int
+	SwitchedOff int
+	Reassigned  int
+}
+
+// Optimize runs the optimizer for each snapshot of the scenario independently. If stepTimeout is positive,
+// it limits the time of optimization of each snapshot. It returns an error of the first failed snapshot.
+func Optimize(
+	ctx context.Context,
+	opt optimizer.Optimizer,
+	scenario *data.Scenario,
+	stepTimeout time.Duration,
+) ([]Step, error) {
+	steps := make([]Step, len(scenario.Snapshots))
+
+	var previous *data.Snapshot
+	var previousResult *optimizer.Result
+
+	for i := range scenario.Snapshots {
+		snapshot := &scenario.Snapshots[i]
+
+		result, err := optimizeSnapshot(ctx, opt, &snapshot.Data, stepTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %d: %w", i, err)
+		}
+
+		steps[i] = compare(previous, previousResult, snapshot, result)
+		previous, previousResult = snapshot, result
+	}
+
+	return steps, nil
+}
+
+func optimizeSnapshot(
+	ctx context.Context,
+	opt optimizer.Optimizer,
+	snapshot *data.Data,
+	timeout time.Duration,
+) (*optimizer.Result, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return opt.Optimize(ctx, snapshot)
+}
+
+func compare(
+	previous *data.Snapshot,
+	previousResult *optimizer.Result,
+	current *data.Snapshot,
+	currentResult *optimizer.Result,
+) Step {
+	step := Step{Result: currentResult, Vehicles: len(current.VehicleIDs)}
+
+	previousRRHs := make([]bool, len(currentResult.RRHEnable))
+	previousAssignment := make(map[int]int)
+
+	if previous != nil {
+		previousRRHs = previousResult.RRHEnable
+
+		for i, id := range previous.VehicleIDs {
+			previousAssignment[id] = previousResult.VehiclesToRRHAssignment[i]
+		}
+	}
+
+	for rrh, isEnabled := range currentResult.RRHEnable {
+		switch {
+		case isEnabled && !previousRRHs[rrh]:
+			step.SwitchedOn++
+		case !isEnabled && previousRRHs[rrh]:
+			step.SwitchedOff++
+		}
+	}
+
+	for i, id := range current.VehicleIDs {
+		previousRRH, ok := previousAssignment[id]
+		if !ok {
+			step.Entered++
+			continue
+		}
+
+		if previousRRH != currentResult.VehiclesToRRHAssignment[i] {
+			step.Reassigned++
+		}
+
+		delete(previousAssignment, id)
+	}
+
+	step.Left = len(previousAssignment)
+
+	return step
+}
@@ -0,0 +1,78 @@
+package scenario
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/firstfit"
+	"github.com/stretchr/testify/assert"
+)
+
+type optimizerFunc func(ctx context.Context, d *data.Data) (*optimizer.Result, error)
+
+func (f optimizerFunc) Optimize(ctx context.Context, d *data.Data) (*optimizer.Result, error) {
+	return f(ctx, d)
+}
+
+func TestOptimize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report changes between consecutive snapshots", func(t *testing.T) {
+		t.Parallel()
+
+		s := &data.Scenario{Snapshots: []data.Snapshot{
+			{Data: data.Data{MRB: []int{5, 5, 5}, R: [][]int{{3, 3, 3}, {2, 2, 2}}}, VehicleIDs: []int{1, 2}},
+			{Data: data.Data{MRB: []int{5, 5, 5}, R: [][]int{{3, 3, 3}, {4, 4, 4}, {9, 9, 1}}}, VehicleIDs: []int{2, 1, 3}},
+			{Data: data.Data{MRB: []int{5, 5, 5}, R: [][]int{}}, VehicleIDs: []int{}},
+		}}
+
+		steps, err := Optimize(context.TODO(), firstfit.FirstFit{}, s, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, steps, 3)
+
+		assert.Equal(t, []int{0, 0}, steps[0].Result.VehiclesToRRHAssignment)
+		assert.Equal(t, Step{Result: steps[0].Result, Vehicles: 2, Entered: 2, SwitchedOn: 1}, steps[0])
+
+		assert.Equal(t, []int{0, 1, 2}, steps[1].Result.VehiclesToRRHAssignment)
+		assert.Equal(t, Step{Result: steps[1].Result, Vehicles: 3, Entered: 1, SwitchedOn: 2, Reassigned: 1}, steps[1])
+
+		assert.Equal(t, Step{Result: steps[2].Result, Left: 3, SwitchedOff: 3}, steps[2])
+	})
+
+	t.Run("should return error of failed snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		s := &data.Scenario{Snapshots: []data.Snapshot{
+			{Data: data.Data{MRB: []int{5}, R: [][]int{{3}}}, VehicleIDs: []int{1}},
+			{Data: data.Data{MRB: []int{5}, R: [][]int{{6}}}, VehicleIDs: []int{1}},
+		}}
+
+		steps, err := Optimize(context.TODO(), firstfit.FirstFit{}, s, 0)
+
+		assert.ErrorIs(t, err, optimizer.ErrCannotAssignToBucket)
+		assert.Nil(t, steps)
+	})
+
+	t.Run("should limit time of optimization of each snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		s := &data.Scenario{Snapshots: []data.Snapshot{
+			{Data: data.Data{MRB: []int{5}, R: [][]int{{3}}}, VehicleIDs: []int{1}},
+		}}
+
+		opt := optimizerFunc(func(ctx context.Context, d *data.Data) (*optimizer.Result, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		steps, err := Optimize(context.TODO(), opt, s, time.Millisecond)
+
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Nil(t, steps)
+	})
+}
@@ -0,0 +1,110 @@
+package tabu
+
+import "github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+
+// objective describes the quality of a solution. Solutions are compared by count first and by spread afterwards.
+type objective struct {
+	count  int
+	spread float64
+}
+
+func (o objective) isBetter(other objective) bool {
+	if o.count != other.count {
+		return o.count < other.count
+	}
+	return o.spread > other.spread
+}
+
+// move is either a reassignment of the item to the bucket (if other is negative)
+// or a swap of buckets of the item and the other item. The result is the objective of the solution
+// obtained after applying the move.
+type move struct {
+	item   int
+	other  int
+	bucket int
+	result objective
+}
+
+func newReassignment(packing *helper.Packing, current objective, item, bucket int) move {
+	source := packing.Bucket(item)
+	r := packing.Data().R[item]
+
+	spreadDelta := squaredFillDelta(packing, source, packing.Load(source)-r[source]) +
+		squaredFillDelta(packing, bucket, packing.Load(bucket)+r[bucket])
+
+	count := current.count
+	if packing.ItemCount(source) == 1 {
+		count--
+	}
+
+	return move{
+		item:   item,
+		other:  -1,
+		bucket: bucket,
+		result: objective{count: count, spread: current.spread + spreadDelta},
+	}
+}
+
+// newSwap returns the swap of the item and the other item. Swaps don't change the number of used buckets,
+// but they change the load of buckets and allow for reaching reassignments that were not possible before.
+func newSwap(packing *helper.Packing, current objective, item, other int) move {
+	first, second := packing.Bucket(item), packing.Bucket(other)
+	newFirstLoad, newSecondLoad := swappedLoads(packing, item, other)
+
+	spreadDelta := squaredFillDelta(packing, first, newFirstLoad) + squaredFillDelta(packing, second, newSecondLoad)
+
+	return move{
+		item:   item,
+		other:  other,
+		bucket: second,
+		result: objective{count: current.count, spread: current.spread + spreadDelta},
+	}
+}
+
+// squaredFillDelta returns the change of the squared fill of the bucket caused by changing its load to the given one.
+func squaredFillDelta(packing *helper.Packing, bucket, newLoad int) float64 {
+	return packing.SquaredFill(bucket, newLoad) - packing.SquaredFill(bucket, packing.Load(bucket))
+}
+
+func canSwap(packing *helper.Packing, item, other int) bool {
+	first, second := packing.Bucket(item), packing.Bucket(other)
+	if first == second {
+		return false
+	}
+
+	newFirstLoad, newSecondLoad := swappedLoads(packing, item, other)
+	mrb := packing.Data().MRB
+
+	return newFirstLoad <= mrb[first] && newSecondLoad <= mrb[second]
+}
+
+// swappedLoads returns loads of buckets of the item and the other item after swapping them.
+func swappedLoads(packing *helper.Packing, item, other int) (int, int) {
+	first, second := packing.Bucket(item), packing.Bucket(other)
+	r := packing.Data().R
+
+	return packing.Load(first) - r[item][first] + r[other][first],
+		packing.Load(second) - r[other][second] + r[item][second]
+}
+
+// isTabu returns true if the move brings any item back to the bucket it left recently.
+func (m move) isTabu(tabuUntil [][]int, packing *helper.Packing, iteration int) bool {
+	if tabuUntil[m.item][m.bucket] > iteration {
+		return true
+	}
+	return m.other >= 0 && tabuUntil[m.other][packing.Bucket(m.item)] > iteration
+}
+
+// apply applies the move to the packing and forbids moved items to come back to their buckets
+// until the given iteration.
+func (m move) apply(tabuUntil [][]int, packing *helper.Packing, until int) {
+	source := packing.Bucket(m.item)
+
+	tabuUntil[m.item][source] = until
+	packing.Move(m.item, m.bucket)
+
+	if m.other >= 0 {
+		tabuUntil[m.other][m.bucket] = until
+		packing.Move(m.other, source)
+	}
+}
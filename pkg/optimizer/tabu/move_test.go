@@ -0,0 +1,110 @@
+package tabu
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_objective_isBetter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should prefer fewer buckets", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, objective{count: 1, spread: 0.4}.isBetter(objective{count: 2, spread: 1}))
+		assert.False(t, objective{count: 2, spread: 1}.isBetter(objective{count: 1, spread: 0.4}))
+	})
+
+	t.Run("should prefer greater spread if number of buckets is equal", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, objective{count: 2, spread: 1}.isBetter(objective{count: 2, spread: 0.8}))
+		assert.False(t, objective{count: 2, spread: 0.8}.isBetter(objective{count: 2, spread: 0.8}))
+	})
+}
+
+func Test_move(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 10},
+		R: [][]int{
+			{6, 6},
+			{2, 2},
+			{2, 2},
+		},
+	}
+
+	t.Run("should evaluate and apply reassignment", func(t *testing.T) {
+		t.Parallel()
+
+		packing := helper.NewPacking(d, []int{0, 1, 1})
+		current := objective{count: 2, spread: spread(packing)}
+		tabuUntil := [][]int{{0, 0}, {0, 0}, {0, 0}}
+
+		m := newReassignment(packing, current, 1, 0)
+		m.apply(tabuUntil, packing, 3)
+
+		assert.Equal(t, 2, m.result.count)
+		assert.InDelta(t, spread(packing), m.result.spread, 1e-9)
+		assert.Equal(t, []int{0, 0, 1}, packing.Assignment())
+		assert.Equal(t, 3, tabuUntil[1][1])
+		assert.True(t, newReassignment(packing, m.result, 1, 1).isTabu(tabuUntil, packing, 2))
+		assert.False(t, newReassignment(packing, m.result, 1, 1).isTabu(tabuUntil, packing, 3))
+	})
+
+	t.Run("should decrease number of buckets if reassignment empties bucket", func(t *testing.T) {
+		t.Parallel()
+
+		packing := helper.NewPacking(d, []int{0, 0, 1})
+		current := objective{count: 2, spread: spread(packing)}
+
+		m := newReassignment(packing, current, 2, 0)
+		packing.Move(2, 0)
+
+		assert.Equal(t, 1, m.result.count)
+		assert.InDelta(t, spread(packing), m.result.spread, 1e-9)
+	})
+
+	t.Run("should evaluate and apply swap", func(t *testing.T) {
+		t.Parallel()
+
+		packing := helper.NewPacking(d, []int{0, 1, 1})
+		current := objective{count: 2, spread: spread(packing)}
+		tabuUntil := [][]int{{0, 0}, {0, 0}, {0, 0}}
+
+		assert.True(t, canSwap(packing, 0, 1))
+		assert.False(t, canSwap(packing, 1, 2))
+
+		m := newSwap(packing, current, 0, 1)
+		m.apply(tabuUntil, packing, 4)
+
+		assert.Equal(t, 2, m.result.count)
+		assert.InDelta(t, spread(packing), m.result.spread, 1e-9)
+		assert.Equal(t, []int{1, 0, 1}, packing.Assignment())
+		assert.Equal(t, 4, tabuUntil[0][0])
+		assert.Equal(t, 4, tabuUntil[1][1])
+	})
+
+	t.Run("should measure load of buckets with occupied space", func(t *testing.T) {
+		t.Parallel()
+
+		d := &data.Data{MRB: []int{10, 10}, R: [][]int{{9, 9}, {1, 1}, {1, 1}}}
+		packing := helper.NewPacking(d, []int{0, 1, 1})
+		current := objective{count: 2, spread: spread(packing)}
+
+		assert.InDelta(t, 0.85, current.spread, 1e-9)
+		assert.True(t, newReassignment(packing, current, 1, 0).result.isBetter(current))
+	})
+
+	t.Run("should reject swap exceeding size of bucket", func(t *testing.T) {
+		t.Parallel()
+
+		packing := helper.NewPacking(&data.Data{MRB: []int{10, 5}, R: [][]int{{6, 6}, {2, 2}}}, []int{0, 1})
+
+		assert.False(t, canSwap(packing, 0, 1))
+	})
+}
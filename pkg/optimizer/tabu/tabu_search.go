@@ -0,0 +1,124 @@
+package tabu
+
+import (
+	"context"
+	"time"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer"
+	"github.com/lothar1998/v2x-optimizer/pkg/optimizer/helper"
+)
+
+// TabuSearch is an optimizer that improves the solution found by InitialOptimizer using tabu search.
+// The neighborhood of a solution consists of moves of a single item to another bucket that is already in use
+// and of swaps of two items assigned to different buckets. In each iteration the best admissible move is applied,
+// even if it worsens the solution. The solutions are compared hierarchically: the one with fewer used buckets
+// is better, and among solutions with the same number of buckets, the one with more lopsided load of buckets
+// (i.e. the greater sum of squared ratios of occupied space to the size of a bucket) is better (see helper.Packing).
+// After an item leaves a bucket, it cannot come back to it for Tenure iterations unless such a move leads
+// to the solution better than the best one found so far (aspiration criterion).
+// The process stops after Iterations iterations, if there is no admissible move, after TimeLimit
+// (if greater than zero) or when the context is done, and returns the best solution found.
+type TabuSearch struct {
+	InitialOptimizer optimizer.Optimizer
+	Iterations       int
+	Tenure           int
+	TimeLimit        time.Duration
+}
+
+func (s TabuSearch) Optimize(ctx context.Context, data *data.Data) (*optimizer.Result, error) {
+	initialResult, err := s.InitialOptimizer.Optimize(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.TimeLimit > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.TimeLimit)
+		defer cancel()
+	}
+
+	if len(data.R) == 0 {
+		return initialResult, nil
+	}
+
+	packing := helper.NewPacking(data, initialResult.VehiclesToRRHAssignment)
+
+	current := objective{count: packing.UsedCount(), spread: spread(packing)}
+	best := current
+	bestAssignment := make([]int, len(data.R))
+	copy(bestAssignment, packing.Assignment())
+
+	tabuUntil := make([][]int, len(data.R))
+	for item := range tabuUntil {
+		tabuUntil[item] = make([]int, len(data.MRB))
+	}
+
+	for iteration := 0; iteration < s.Iterations; iteration++ {
+		select {
+		case <-ctx.Done():
+			return helper.ToResult(bestAssignment, len(data.MRB)), nil
+		default:
+		}
+
+		isAdmissible := func(m move) bool {
+			return !m.isTabu(tabuUntil, packing, iteration) || m.result.isBetter(best)
+		}
+
+		m, ok := bestMove(packing, current, isAdmissible)
+		if !ok {
+			break
+		}
+
+		m.apply(tabuUntil, packing, iteration+s.Tenure)
+		current = m.result
+
+		if current.isBetter(best) {
+			best = current
+			copy(bestAssignment, packing.Assignment())
+		}
+	}
+
+	return helper.ToResult(bestAssignment, len(data.MRB)), nil
+}
+
+// bestMove returns the best admissible move in the neighborhood of the current solution.
+// It returns false if there is no admissible move.
+func bestMove(packing *helper.Packing, current objective, isAdmissible func(move) bool) (move, bool) {
+	var best move
+	var found bool
+
+	consider := func(m move) {
+		if (!found || m.result.isBetter(best.result)) && isAdmissible(m) {
+			best = m
+			found = true
+		}
+	}
+
+	assignment := packing.Assignment()
+
+	for item := range assignment {
+		for _, bucket := range packing.UsedBuckets() {
+			if packing.CanMove(item, bucket) {
+				consider(newReassignment(packing, current, item, bucket))
+			}
+		}
+
+		for other := item + 1; other < len(assignment); other++ {
+			if canSwap(packing, item, other) {
+				consider(newSwap(packing, current, item, other))
+			}
+		}
+	}
+
+	return best, found
+}
+
+// spread returns the sum of squared ratios of occupied space to the size of a bucket.
+func spread(packing *helper.Packing) float64 {
+	var sum float64
+	for _, bucket := range packing.UsedBuckets() {
+		sum += packing.SquaredFill(bucket, packing.Load(bucket))
+	}
+	return sum
+}
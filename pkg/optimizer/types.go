@@ -8,6 +8,7 @@ import (
 )
 
 // Optimizer allows for optimizing problem using given data.Data.
+// Implementations must treat the given data.Data as immutable, so the same data can be reused by many optimizers.
 type Optimizer interface {
 	Optimize(context.Context, *data.Data) (*Result, error)
 }
@@ -0,0 +1,68 @@
+package optimizer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+)
+
+// ErrInvalidResult is returned by Validate if the result is not a feasible solution for the given data.
+var ErrInvalidResult = errors.New("invalid result")
+
+// Validate checks if the result is a feasible solution for the given data. It verifies that sizes of the result
+// match the data, every vehicle is assigned to an enabled RRH, no RRH exceeds its MRB
+// and RRHCount is equal to the number of enabled RRHs. It returns an error wrapping ErrInvalidResult
+// that describes the first found violation.
+func Validate(data *data.Data, result *Result) error {
+	if result == nil {
+		return fmt.Errorf("%w: result is nil", ErrInvalidResult)
+	}
+
+	n := len(data.MRB)
+	v := len(data.R)
+
+	if len(result.RRHEnable) != n {
+		return fmt.Errorf("%w: expected %d RRHs but got %d", ErrInvalidResult, n, len(result.RRHEnable))
+	}
+
+	if len(result.VehiclesToRRHAssignment) != v {
+		return fmt.Errorf("%w: expected %d vehicles but got %d",
+			ErrInvalidResult, v, len(result.VehiclesToRRHAssignment))
+	}
+
+	enabledCount := 0
+	for _, isEnabled := range result.RRHEnable {
+		if isEnabled {
+			enabledCount++
+		}
+	}
+
+	if result.RRHCount != enabledCount {
+		return fmt.Errorf("%w: RRH count is equal to %d but %d RRHs are enabled",
+			ErrInvalidResult, result.RRHCount, enabledCount)
+	}
+
+	leftSpace := make([]int, n)
+	copy(leftSpace, data.MRB)
+
+	for vehicle, rrh := range result.VehiclesToRRHAssignment {
+		if rrh < 0 || rrh >= n || rrh >= len(data.R[vehicle]) {
+			return fmt.Errorf("%w: vehicle %d is assigned to non-existent RRH %d", ErrInvalidResult, vehicle, rrh)
+		}
+
+		if !result.RRHEnable[rrh] {
+			return fmt.Errorf("%w: vehicle %d is assigned to disabled RRH %d", ErrInvalidResult, vehicle, rrh)
+		}
+
+		leftSpace[rrh] -= data.R[vehicle][rrh]
+	}
+
+	for rrh, space := range leftSpace {
+		if space < 0 {
+			return fmt.Errorf("%w: RRH %d exceeds its MRB of %d by %d", ErrInvalidResult, rrh, data.MRB[rrh], -space)
+		}
+	}
+
+	return nil
+}
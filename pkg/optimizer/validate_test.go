@@ -0,0 +1,93 @@
+package optimizer
+
+import (
+	"testing"
+
+	"github.com/lothar1998/v2x-optimizer/pkg/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	d := &data.Data{
+		MRB: []int{10, 8, 5},
+		R: [][]int{
+			{4, 3, 2},
+			{6, 5, 4},
+			{2, 3, 1},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		result  *Result
+		isValid bool
+	}{
+		{
+			"should accept feasible result",
+			&Result{RRHCount: 2, RRHEnable: []bool{true, false, true}, VehiclesToRRHAssignment: []int{0, 0, 2}},
+			true,
+		},
+		{
+			"should accept feasible result with enabled but unused RRH",
+			&Result{RRHCount: 3, RRHEnable: []bool{true, true, true}, VehiclesToRRHAssignment: []int{0, 0, 2}},
+			true,
+		},
+		{
+			"should reject nil result",
+			nil,
+			false,
+		},
+		{
+			"should reject result with wrong number of RRHs",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false}, VehiclesToRRHAssignment: []int{0, 0, 0}},
+			false,
+		},
+		{
+			"should reject result with wrong number of vehicles",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false, false}, VehiclesToRRHAssignment: []int{0, 0}},
+			false,
+		},
+		{
+			"should reject result with RRH count not matching enabled RRHs",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false, true}, VehiclesToRRHAssignment: []int{0, 0, 2}},
+			false,
+		},
+		{
+			"should reject result with vehicle assigned to disabled RRH",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false, false}, VehiclesToRRHAssignment: []int{0, 0, 2}},
+			false,
+		},
+		{
+			"should reject result with vehicle assigned to non-existent RRH",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false, false}, VehiclesToRRHAssignment: []int{0, 0, 3}},
+			false,
+		},
+		{
+			"should reject result with negative assignment",
+			&Result{RRHCount: 1, RRHEnable: []bool{true, false, false}, VehiclesToRRHAssignment: []int{0, -1, 0}},
+			false,
+		},
+		{
+			"should reject result with RRH exceeding its MRB",
+			&Result{RRHCount: 1, RRHEnable: []bool{false, true, false}, VehiclesToRRHAssignment: []int{1, 1, 1}},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(d, tt.result)
+
+			if tt.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidResult)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -33,6 +34,20 @@ func (m *MockProcess) EXPECT() *MockProcessMockRecorder {
 	return m.recorder
 }
 
+// CPUTime mocks base method.
+func (m *MockProcess) CPUTime() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CPUTime")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// CPUTime indicates an expected call of CPUTime.
+func (mr *MockProcessMockRecorder) CPUTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CPUTime", reflect.TypeOf((*MockProcess)(nil).CPUTime))
+}
+
 // Output mocks base method.
 func (m *MockProcess) Output() ([]byte, error) {
 	m.ctrl.T.Helper()